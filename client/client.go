@@ -13,6 +13,8 @@ import (
 	"github.com/ava-labs/coreth/interfaces"
 	"github.com/ava-labs/coreth/plugin/evm"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/ava-labs/avalanche-rosetta/client/contractregistry"
 )
 
 // Interface compliance
@@ -27,12 +29,14 @@ type Client interface {
 	HeaderByNumber(context.Context, *big.Int) (*ethtypes.Header, error)
 	TransactionByHash(context.Context, ethcommon.Hash) (*ethtypes.Transaction, bool, error)
 	TransactionReceipt(context.Context, ethcommon.Hash) (*ethtypes.Receipt, error)
+	TransactionReceipts(context.Context, []ethcommon.Hash) ([]*ethtypes.Receipt, error)
 	TraceTransaction(context.Context, string) (*Call, []*FlatCall, error)
 	TraceBlockByHash(context.Context, string) ([]*Call, [][]*FlatCall, error)
 	SendTransaction(context.Context, *ethtypes.Transaction) error
 	BalanceAt(context.Context, ethcommon.Address, *big.Int) (*big.Int, error)
 	NonceAt(context.Context, ethcommon.Address, *big.Int) (uint64, error)
 	SuggestGasPrice(context.Context) (*big.Int, error)
+	SuggestGasTipCap(context.Context) (*big.Int, error)
 	EstimateGas(context.Context, interfaces.CallMsg) (uint64, error)
 	TxPoolContent(context.Context) (*TxPoolContent, error)
 	GetNetworkName(context.Context, ...rpc.Option) (string, error)
@@ -55,8 +59,9 @@ type client struct {
 	*ContractClient
 }
 
-// NewClient returns a new client for Avalanche APIs
-func NewClient(ctx context.Context, endpoint string) (Client, error) {
+// NewClient returns a new client for Avalanche APIs, persisting contract
+// information in [registry] when not nil
+func NewClient(ctx context.Context, endpoint string, registry *contractregistry.Registry) (Client, error) {
 	endpoint = strings.TrimSuffix(endpoint, "/")
 
 	eth, err := NewEthClient(ctx, endpoint)
@@ -68,6 +73,6 @@ func NewClient(ctx context.Context, endpoint string) (Client, error) {
 		Client:         info.NewClient(endpoint),
 		EvmClient:      evm.NewClient(endpoint, "C"),
 		EthClient:      eth,
-		ContractClient: NewContractClient(eth.Client),
+		ContractClient: NewContractClient(eth.Client, registry),
 	}, nil
 }
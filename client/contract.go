@@ -1,50 +1,87 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+
 	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/coreth/accounts/abi"
 	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ava-labs/coreth/interfaces"
+	"github.com/ava-labs/coreth/rpc"
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ava-labs/avalanche-rosetta/client/contractregistry"
 )
 
 const (
 	contractCacheSize = 1024
+
+	// contractInfoABIJSON describes the contract methods looked up for token metadata
+	contractInfoABIJSON = `[
+		{"type":"function","stateMutability":"view","name":"name","inputs":[],"outputs":[{"type":"string"}]},
+		{"type":"function","stateMutability":"view","name":"symbol","inputs":[],"outputs":[{"type":"string"}]},
+		{"type":"function","stateMutability":"view","name":"decimals","inputs":[],"outputs":[{"type":"uint8"}]},
+		{"type":"function","stateMutability":"view","name":"supportsInterface","inputs":[{"type":"bytes4"}],"outputs":[{"type":"bool"}]}
+	]`
+)
+
+// Interface compliance
+var (
+	_ ContractCache = &ContractClient{}
+	_ ContractCache = ContractCaches{}
+)
+
+var (
+	contractInfoABI, _ = abi.JSON(strings.NewReader(contractInfoABIJSON))
+
+	// ERC-165 interface ids of the detected standards, ERC-20 has none
+	erc165Standards = []struct {
+		interfaceID [4]byte
+		standard    string
+	}{
+		{interfaceID: [4]byte{0x80, 0xac, 0x58, 0xcd}, standard: contractregistry.StandardERC721},
+		{interfaceID: [4]byte{0xd9, 0xb6, 0x7a, 0x26}, standard: contractregistry.StandardERC1155},
+	}
 )
 
+// ContractCache is implemented by clients caching contract information
+type ContractCache interface {
+	ContractCacheStats() (hits uint64, misses uint64)
+}
+
 // ContractClient is a client for the calling contract information
 type ContractClient struct {
 	ethClient ethclient.Client
 	cache     *cache.LRU
+
+	// registry persists the contract information, when enabled
+	registry *contractregistry.Registry
+
+	cacheHits   uint64
+	cacheMisses uint64
 }
 
-// NewContractClient returns a new ContractInfo client
-func NewContractClient(c ethclient.Client) *ContractClient {
+// NewContractClient returns a new ContractInfo client, persisting contract
+// information in [registry] when not nil
+func NewContractClient(c ethclient.Client, registry *contractregistry.Registry) *ContractClient {
 	return &ContractClient{
 		ethClient: c,
 		cache:     &cache.LRU{Size: contractCacheSize},
+		registry:  registry,
 	}
 }
 
 // GetContractInfo returns the symbol and decimals for [addr].
 func (c *ContractClient) GetContractInfo(addr common.Address, erc20 bool) (string, uint8, error) {
-	// We don't define another struct because this is never used outside of this
-	// function.
-	type ContractInfo struct {
-		Symbol   string
-		Decimals uint8
-	}
-
-	if currency, cached := c.cache.Get(addr); cached {
-		cast := currency.(*ContractInfo)
-		return cast.Symbol, cast.Decimals, nil
-	}
-
-	token, err := NewContractInfoToken(addr, c.ethClient)
+	contract, err := c.getContract(addr)
 	if err != nil {
 		return "", 0, err
 	}
 
-	// [symbol] is set to "" if [token.Symbol] errors.
-	symbol, _ := token.Symbol(nil)
+	symbol := contract.Symbol
 	if symbol == "" {
 		if erc20 {
 			symbol = UnknownERC20Symbol
@@ -52,14 +89,133 @@ func (c *ContractClient) GetContractInfo(addr common.Address, erc20 bool) (strin
 			symbol = UnknownERC721Symbol
 		}
 	}
+	return symbol, contract.Decimals, nil
+}
+
+func (c *ContractClient) getContract(addr common.Address) (*contractregistry.Contract, error) {
+	if contract, cached := c.cache.Get(addr); cached {
+		atomic.AddUint64(&c.cacheHits, 1)
+		return contract.(*contractregistry.Contract), nil
+	}
+	atomic.AddUint64(&c.cacheMisses, 1)
+
+	if c.registry != nil {
+		contract, ok, err := c.registry.Get(addr)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			c.cache.Put(addr, contract)
+			return contract, nil
+		}
+	}
+
+	// Failed calls are not cached, so that the lookup is retried
+	contract, err := c.fetchContract(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.registry != nil {
+		if err := c.registry.Put(contract); err != nil {
+			return nil, err
+		}
+	}
+	c.cache.Put(addr, contract)
+	return contract, nil
+}
+
+// fetchContract looks up the metadata of [addr] from the node
+func (c *ContractClient) fetchContract(addr common.Address) (*contractregistry.Contract, error) {
+	contract := &contractregistry.Contract{Address: addr.Hex()}
+
+	symbol, ok, err := c.call(addr, "symbol")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		contract.Symbol = symbol.(string)
+	}
+
+	name, ok, err := c.call(addr, "name")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		contract.Name = name.(string)
+	}
+
+	decimals, hasDecimals, err := c.call(addr, "decimals")
+	if err != nil {
+		return nil, err
+	}
+	if hasDecimals {
+		contract.Decimals = decimals.(uint8)
+	}
+
+	for _, erc165Standard := range erc165Standards {
+		supported, ok, err := c.call(addr, "supportsInterface", erc165Standard.interfaceID)
+		if err != nil {
+			return nil, err
+		}
+		if ok && supported.(bool) {
+			contract.Standard = erc165Standard.standard
+			break
+		}
+	}
+	if contract.Standard == "" && hasDecimals {
+		contract.Standard = contractregistry.StandardERC20
+	}
 
-	// [decimals] is set to 0 if [token.Decimals] errors.
-	decimals, _ := token.Decimals(nil)
+	return contract, nil
+}
 
-	// Cache defaults for contract address to avoid unnecessary lookups
-	c.cache.Put(addr, &ContractInfo{
-		Symbol:   symbol,
-		Decimals: decimals,
-	})
-	return symbol, decimals, nil
+// call returns the output of [method] of [addr]. ok is false when the contract
+// doesn't implement [method], err is only set when the call failed.
+func (c *ContractClient) call(addr common.Address, method string, args ...interface{}) (output interface{}, ok bool, err error) {
+	input, err := contractInfoABI.Pack(method, args...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	outputBytes, err := c.ethClient.CallContract(context.Background(), interfaces.CallMsg{To: &addr, Data: input}, nil)
+	if err != nil {
+		if isExecutionError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	// Contracts without [method] may return empty or malformed outputs
+	outputs, err := contractInfoABI.Unpack(method, outputBytes)
+	if err != nil || len(outputs) != 1 {
+		return nil, false, nil
+	}
+	return outputs[0], true, nil
+}
+
+// isExecutionError returns true when the node answered the call with an EVM
+// error, such as a revert or running out of gas, which it answers the same way
+// on every call. Unreachable node and timeout errors are retried.
+func isExecutionError(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) && !isTransient(err)
+}
+
+// ContractCacheStats returns the number of contract info cache hits and misses
+func (c *ContractClient) ContractCacheStats() (hits uint64, misses uint64) {
+	return atomic.LoadUint64(&c.cacheHits), atomic.LoadUint64(&c.cacheMisses)
+}
+
+// ContractCaches combines the contract cache stats of several clients
+type ContractCaches []ContractCache
+
+// ContractCacheStats returns the sum of the contract info cache hits and misses
+func (c ContractCaches) ContractCacheStats() (hits uint64, misses uint64) {
+	for _, cache := range c {
+		cacheHits, cacheMisses := cache.ContractCacheStats()
+		hits += cacheHits
+		misses += cacheMisses
+	}
+	return hits, misses
 }
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ava-labs/coreth/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/client/contractregistry"
+)
+
+// contractCallMock answers contract calls by method name, methods without an
+// output revert
+type contractCallMock struct {
+	ethclient.Client
+
+	outputs map[string][]byte
+	err     error
+	calls   int
+}
+
+func (c *contractCallMock) CallContract(_ context.Context, msg interfaces.CallMsg, _ *big.Int) ([]byte, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	method, err := contractInfoABI.MethodById(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	output, ok := c.outputs[method.Name]
+	if !ok {
+		return nil, &rpcErrorMock{code: -32000, message: "execution reverted"}
+	}
+	return output, nil
+}
+
+// rpcErrorMock is an error answered by the node
+type rpcErrorMock struct {
+	code    int
+	message string
+	data    interface{}
+}
+
+func (e *rpcErrorMock) Error() string          { return e.message }
+func (e *rpcErrorMock) ErrorCode() int         { return e.code }
+func (e *rpcErrorMock) ErrorData() interface{} { return e.data }
+
+func packOutput(t *testing.T, method string, value interface{}) []byte {
+	output, err := contractInfoABI.Methods[method].Outputs.Pack(value)
+	assert.Nil(t, err)
+	return output
+}
+
+func TestGetContractInfo(t *testing.T) {
+	addr := common.HexToAddress("0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7")
+
+	t.Run("erc20 contract", func(t *testing.T) {
+		ethClient := &contractCallMock{outputs: map[string][]byte{
+			"symbol":   packOutput(t, "symbol", "WAVAX"),
+			"name":     packOutput(t, "name", "Wrapped AVAX"),
+			"decimals": packOutput(t, "decimals", uint8(18)),
+		}}
+		registry := contractregistry.New(memdb.New())
+		c := NewContractClient(ethClient, registry)
+
+		symbol, decimals, err := c.GetContractInfo(addr, true)
+		assert.Nil(t, err)
+		assert.Equal(t, "WAVAX", symbol)
+		assert.Equal(t, uint8(18), decimals)
+
+		contract, ok, err := registry.Get(addr)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, &contractregistry.Contract{
+			Address:  addr.Hex(),
+			Symbol:   "WAVAX",
+			Name:     "Wrapped AVAX",
+			Decimals: 18,
+			Standard: contractregistry.StandardERC20,
+		}, contract)
+
+		// Registered contracts are not looked up again
+		calls := ethClient.calls
+		_, _, err = NewContractClient(ethClient, registry).GetContractInfo(addr, true)
+		assert.Nil(t, err)
+		assert.Equal(t, calls, ethClient.calls)
+	})
+
+	t.Run("erc721 contract without symbol", func(t *testing.T) {
+		ethClient := &contractCallMock{outputs: map[string][]byte{
+			"supportsInterface": packOutput(t, "supportsInterface", true),
+		}}
+		registry := contractregistry.New(memdb.New())
+		c := NewContractClient(ethClient, registry)
+
+		symbol, decimals, err := c.GetContractInfo(addr, false)
+		assert.Nil(t, err)
+		assert.Equal(t, UnknownERC721Symbol, symbol)
+		assert.Equal(t, uint8(0), decimals)
+
+		symbol, _, err = c.GetContractInfo(addr, true)
+		assert.Nil(t, err)
+		assert.Equal(t, UnknownERC20Symbol, symbol)
+
+		contract, ok, err := registry.Get(addr)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, contractregistry.StandardERC721, contract.Standard)
+	})
+
+	t.Run("contract without token methods", func(t *testing.T) {
+		for name, callErr := range map[string]error{
+			"revert":         &rpcErrorMock{code: 3, message: "execution reverted: unknown method", data: "0x08c379a0"},
+			"out of gas":     &rpcErrorMock{code: -32000, message: "out of gas"},
+			"invalid opcode": &rpcErrorMock{code: -32000, message: "invalid opcode: INVALID"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				ethClient := &contractCallMock{err: callErr}
+				registry := contractregistry.New(memdb.New())
+				c := NewContractClient(ethClient, registry)
+
+				symbol, decimals, err := c.GetContractInfo(addr, true)
+				assert.Nil(t, err)
+				assert.Equal(t, UnknownERC20Symbol, symbol)
+				assert.Equal(t, uint8(0), decimals)
+
+				contract, ok, err := registry.Get(addr)
+				assert.Nil(t, err)
+				assert.True(t, ok)
+				assert.Equal(t, &contractregistry.Contract{Address: addr.Hex()}, contract)
+			})
+		}
+	})
+
+	t.Run("failed calls are retried", func(t *testing.T) {
+		ethClient := &contractCallMock{err: errors.New("connection refused")}
+		registry := contractregistry.New(memdb.New())
+		c := NewContractClient(ethClient, registry)
+
+		_, _, err := c.GetContractInfo(addr, true)
+		assert.NotNil(t, err)
+
+		_, ok, err := registry.Get(addr)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+
+		// Calls timing out are not recorded either
+		ethClient.err = context.DeadlineExceeded
+		_, _, err = c.GetContractInfo(addr, true)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		_, ok, err = registry.Get(addr)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+
+		ethClient.err = nil
+		ethClient.outputs = map[string][]byte{"symbol": packOutput(t, "symbol", "WAVAX")}
+		symbol, _, err := c.GetContractInfo(addr, true)
+		assert.Nil(t, err)
+		assert.Equal(t, "WAVAX", symbol)
+	})
+}
@@ -0,0 +1,154 @@
+package contractregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Token standards detected for the registered contracts
+const (
+	StandardERC20   = "ERC20"
+	StandardERC721  = "ERC721"
+	StandardERC1155 = "ERC1155"
+)
+
+var (
+	contractPrefix = []byte("contract")
+
+	errMissingChainID = errors.New("chain id is required to seed the registry")
+)
+
+// Contract is the metadata of a token contract. Symbol and Name are empty and
+// Decimals is 0 when the contract doesn't implement them, Standard is empty when
+// no standard was detected.
+type Contract struct {
+	Address  string `json:"address"`
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Decimals uint8  `json:"decimals"`
+	Standard string `json:"standard,omitempty"`
+}
+
+// TokenList is a JSON token list, such as the lists following the
+// https://tokenlists.org schema
+type TokenList struct {
+	Tokens []Token `json:"tokens"`
+}
+
+// Token is an ERC-20 token of a TokenList
+type Token struct {
+	ChainID  int64  `json:"chainId"`
+	Address  string `json:"address"`
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Decimals uint8  `json:"decimals"`
+}
+
+// Registry is a persistent registry of the token contracts metadata, so that
+// contracts are only looked up once
+type Registry struct {
+	db        database.Database
+	contracts database.Database
+}
+
+// New returns a registry backed by [db]
+func New(db database.Database) *Registry {
+	return &Registry{
+		db:        db,
+		contracts: prefixdb.New(contractPrefix, db),
+	}
+}
+
+// Open opens the registry stored in [dir], creating it if needed
+func Open(dir string) (*Registry, error) {
+	db, err := leveldb.New(dir, nil, logging.NoLog{}, "contractregistry", prometheus.NewRegistry())
+	if err != nil {
+		return nil, err
+	}
+
+	return New(db), nil
+}
+
+// Close closes the underlying database
+func (r *Registry) Close() error {
+	return r.db.Close()
+}
+
+// Get returns the registered metadata of [addr].
+// ok is false when the contract is not registered.
+func (r *Registry) Get(addr common.Address) (contract *Contract, ok bool, err error) {
+	contractBytes, err := r.contracts.Get(addr.Bytes())
+	if err == database.ErrNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	contract = &Contract{}
+	if err := json.Unmarshal(contractBytes, contract); err != nil {
+		return nil, false, err
+	}
+	return contract, true, nil
+}
+
+// Put registers [contract], replacing its previous metadata
+func (r *Registry) Put(contract *Contract) error {
+	contractBytes, err := json.Marshal(contract)
+	if err != nil {
+		return err
+	}
+
+	return r.contracts.Put(common.HexToAddress(contract.Address).Bytes(), contractBytes)
+}
+
+// Seed registers the ERC-20 tokens of [chainID] listed in the token list file
+// at [path], replacing their previous metadata. Tokens without a chain ID are
+// registered as well. It returns the number of registered tokens.
+func (r *Registry) Seed(path string, chainID int64) (int, error) {
+	if chainID == 0 {
+		return 0, errMissingChainID
+	}
+
+	listBytes, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var list TokenList
+	if err := json.Unmarshal(listBytes, &list); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, token := range list.Tokens {
+		if token.ChainID != 0 && token.ChainID != chainID {
+			continue
+		}
+		if !common.IsHexAddress(token.Address) {
+			continue
+		}
+
+		err := r.Put(&Contract{
+			Address:  common.HexToAddress(token.Address).Hex(),
+			Symbol:   token.Symbol,
+			Name:     token.Name,
+			Decimals: token.Decimals,
+			Standard: StandardERC20,
+		})
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
@@ -0,0 +1,74 @@
+package contractregistry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const wavaxAddress = "0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7"
+
+func TestRegistry(t *testing.T) {
+	t.Run("put and get", func(t *testing.T) {
+		registry := New(memdb.New())
+
+		contract, ok, err := registry.Get(common.HexToAddress(wavaxAddress))
+		assert.Nil(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, contract)
+
+		wavax := &Contract{
+			Address:  wavaxAddress,
+			Symbol:   "WAVAX",
+			Name:     "Wrapped AVAX",
+			Decimals: 18,
+			Standard: StandardERC20,
+		}
+		assert.Nil(t, registry.Put(wavax))
+
+		contract, ok, err = registry.Get(common.HexToAddress(wavaxAddress))
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, wavax, contract)
+	})
+
+	t.Run("seed from token list", func(t *testing.T) {
+		registry := New(memdb.New())
+		path := filepath.Join(t.TempDir(), "tokens.json")
+		assert.Nil(t, os.WriteFile(path, []byte(`{
+			"name": "Avalanche tokens",
+			"tokens": [
+				{"chainId": 43114, "address": "0xb31f66aa3c1e785363f0875a1b74e27b85fd66c7", "symbol": "WAVAX", "name": "Wrapped AVAX", "decimals": 18},
+				{"chainId": 43113, "address": "0xd00ae08403B9bbb9124bB305C09058E32C39A48c", "symbol": "WAVAX", "name": "Wrapped AVAX", "decimals": 18},
+				{"chainId": 43114, "address": "invalid", "symbol": "BAD", "name": "Invalid", "decimals": 18}
+			]
+		}`), 0o600))
+
+		count, err := registry.Seed(path, 43114)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+
+		contract, ok, err := registry.Get(common.HexToAddress(wavaxAddress))
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, &Contract{
+			Address:  wavaxAddress,
+			Symbol:   "WAVAX",
+			Name:     "Wrapped AVAX",
+			Decimals: 18,
+			Standard: StandardERC20,
+		}, contract)
+
+		_, ok, err = registry.Get(common.HexToAddress("0xd00ae08403B9bbb9124bB305C09058E32C39A48c"))
+		assert.Nil(t, err)
+		assert.False(t, ok)
+
+		// Seeding requires a chain ID, or the tokens of all chains would be registered
+		_, err = registry.Seed(path, 0)
+		assert.ErrorIs(t, err, errMissingChainID)
+	})
+}
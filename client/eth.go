@@ -2,11 +2,17 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 
+	ethtypes "github.com/ava-labs/coreth/core/types"
 	"github.com/ava-labs/coreth/eth/tracers"
 	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ava-labs/coreth/interfaces"
 	"github.com/ava-labs/coreth/rpc"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 var (
@@ -15,6 +21,14 @@ var (
 	prefixEth     = "/ext/bc/C/rpc"
 )
 
+// ErrBatchRejected is returned when the node doesn't serve batched requests,
+// or not as large ones, in which case the requests must be sent one by one
+var ErrBatchRejected = errors.New("batch request rejected")
+
+// invalidRequestErrorCode is the JSON-RPC error code of invalid requests, which
+// nodes limiting the batch size return for the requests above the limit
+const invalidRequestErrorCode = -32600
+
 // EthClient provides access to Coreth API
 type EthClient struct {
 	ethclient.Client
@@ -59,7 +73,7 @@ func (c *EthClient) TraceTransaction(ctx context.Context, hash string) (*Call, [
 		return nil, nil, err
 	}
 
-	flattened := result.init()
+	flattened := result.init(0)
 
 	return &result, flattened, nil
 }
@@ -79,8 +93,57 @@ func (c *EthClient) TraceBlockByHash(ctx context.Context, hash string) ([]*Call,
 	flattened := make([][]*FlatCall, len(raw))
 	for i, tx := range raw {
 		result[i] = tx.Call
-		flattened[i] = tx.Call.init()
+		flattened[i] = tx.Call.init(0)
 	}
 
 	return result, flattened, nil
 }
+
+// TransactionReceipts returns the receipts of the transactions in a single
+// batched request
+func (c *EthClient) TransactionReceipts(ctx context.Context, hashes []ethcommon.Hash) ([]*ethtypes.Receipt, error) {
+	receipts := make([]*ethtypes.Receipt, len(hashes))
+	batch := make([]rpc.BatchElem, len(hashes))
+	for i, hash := range hashes {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []interface{}{hash},
+			Result: &receipts[i],
+		}
+	}
+
+	if err := c.rpc.BatchCallContext(ctx, batch); err != nil {
+		if isBatchRejection(err) {
+			return nil, fmt.Errorf("%w: %v", ErrBatchRejected, err)
+		}
+		return nil, err
+	}
+
+	for i, elem := range batch {
+		var rpcErr rpc.Error
+		if errors.As(elem.Error, &rpcErr) && rpcErr.ErrorCode() == invalidRequestErrorCode {
+			return nil, fmt.Errorf("%w: %v", ErrBatchRejected, elem.Error)
+		}
+		if elem.Error != nil {
+			return nil, elem.Error
+		}
+		if receipts[i] == nil {
+			return nil, interfaces.NotFound
+		}
+	}
+
+	return receipts, nil
+}
+
+// isBatchRejection returns true when the node refused a batch request as a
+// whole, either with an HTTP error or by answering it with a single response
+func isBatchRejection(err error) bool {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusMethodNotAllowed ||
+			httpErr.StatusCode == http.StatusRequestEntityTooLarge
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &typeErr)
+}
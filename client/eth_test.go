@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/interfaces"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonRPCRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type jsonRPCResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result"`
+	Error   interface{}     `json:"error,omitempty"`
+}
+
+// newJSONRPCServer returns a client of a JSON-RPC server served by [handler]
+func newJSONRPCServer(t testing.TB, handler http.HandlerFunc) *EthClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewEthClient(context.Background(), server.URL)
+	assert.NoError(t, err)
+	return c
+}
+
+// serveBatch answers each request of a batch with the result of [answer]
+func serveBatch(t testing.TB, answer func(req jsonRPCRequest) jsonRPCResponse) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var reqs []jsonRPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+			t.Error(err)
+			return
+		}
+
+		resps := make([]jsonRPCResponse, len(reqs))
+		for i, req := range reqs {
+			resps[i] = answer(req)
+			resps[i].Version = "2.0"
+			resps[i].ID = req.ID
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resps))
+	}
+}
+
+func TestTransactionReceipts(t *testing.T) {
+	ctx := context.Background()
+	hashes := []ethcommon.Hash{{0x1}, {0x2}}
+	receipts := map[ethcommon.Hash]*ethtypes.Receipt{}
+	for _, hash := range hashes {
+		receipts[hash] = &ethtypes.Receipt{
+			Status:  ethtypes.ReceiptStatusSuccessful,
+			TxHash:  hash,
+			GasUsed: 21_000,
+			Logs:    []*ethtypes.Log{},
+		}
+	}
+	serveReceipts := func(req jsonRPCRequest) jsonRPCResponse {
+		var hash ethcommon.Hash
+		assert.NoError(t, json.Unmarshal(req.Params[0], &hash))
+		return jsonRPCResponse{Result: receipts[hash]}
+	}
+
+	t.Run("receipts are fetched in a batch", func(t *testing.T) {
+		c := newJSONRPCServer(t, serveBatch(t, serveReceipts))
+
+		result, err := c.TransactionReceipts(ctx, hashes)
+		assert.NoError(t, err)
+		assert.Len(t, result, len(hashes))
+		for i, receipt := range result {
+			assert.Equal(t, hashes[i], receipt.TxHash)
+			assert.Equal(t, uint64(21_000), receipt.GasUsed)
+		}
+	})
+
+	t.Run("missing receipts are not found", func(t *testing.T) {
+		c := newJSONRPCServer(t, serveBatch(t, func(req jsonRPCRequest) jsonRPCResponse {
+			return jsonRPCResponse{Result: nil}
+		}))
+
+		_, err := c.TransactionReceipts(ctx, hashes)
+		assert.ErrorIs(t, err, interfaces.NotFound)
+	})
+
+	t.Run("batches refused by the node are rejected", func(t *testing.T) {
+		for name, handler := range map[string]http.HandlerFunc{
+			"method not allowed": func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			},
+			"request too large": func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+			},
+			"single response": func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch requests are not supported"}}`))
+			},
+			"batch too large": serveBatch(t, func(req jsonRPCRequest) jsonRPCResponse {
+				return jsonRPCResponse{Error: map[string]interface{}{"code": -32600, "message": "batch too large"}}
+			}),
+		} {
+			t.Run(name, func(t *testing.T) {
+				c := newJSONRPCServer(t, handler)
+
+				_, err := c.TransactionReceipts(ctx, hashes)
+				assert.ErrorIs(t, err, ErrBatchRejected)
+			})
+		}
+	})
+
+	t.Run("other errors are returned", func(t *testing.T) {
+		for name, handler := range map[string]http.HandlerFunc{
+			"node failure": func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			"call failure": serveBatch(t, func(req jsonRPCRequest) jsonRPCResponse {
+				return jsonRPCResponse{Error: map[string]interface{}{"code": -32000, "message": "database closed"}}
+			}),
+		} {
+			t.Run(name, func(t *testing.T) {
+				c := newJSONRPCServer(t, handler)
+
+				_, err := c.TransactionReceipts(ctx, hashes)
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, ErrBatchRejected)
+			})
+		}
+	})
+}
@@ -0,0 +1,499 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/interfaces"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Interface compliance
+var (
+	_ Client       = &multiClient{}
+	_ PChainClient = &multiPChainClient{}
+)
+
+// blockHeight returns the height a node must have reached to serve [number],
+// which is 0 for the latest block
+func blockHeight(number *big.Int) uint64 {
+	if number == nil || !number.IsUint64() {
+		return 0
+	}
+	return number.Uint64()
+}
+
+type multiClient struct {
+	*nodeSet
+	clients []Client
+}
+
+// NewMultiClient returns a Client routing calls between [clients], which
+// are health checked until [ctx] is done. Reads are sent to the nodes having
+// the requested block and fall back to the other nodes on errors, while
+// transactions are sent to the healthiest node.
+func NewMultiClient(ctx context.Context, name string, clients []Client) Client {
+	checks := make([]nodeCheck, len(clients))
+	for i, c := range clients {
+		c := c
+		checks[i] = func(ctx context.Context) (bool, uint64, error) {
+			bootstrapped, err := c.IsBootstrapped(ctx, "C")
+			if err != nil || !bootstrapped {
+				return false, 0, err
+			}
+
+			header, err := c.HeaderByNumber(ctx, nil)
+			if err != nil {
+				return false, 0, err
+			}
+			return true, header.Number.Uint64(), nil
+		}
+	}
+
+	return &multiClient{
+		nodeSet: newNodeSet(ctx, name, checks),
+		clients: clients,
+	}
+}
+
+func (c *multiClient) IsBootstrapped(ctx context.Context, chain string, options ...rpc.Option) (bootstrapped bool, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		bootstrapped, err = c.clients[i].IsBootstrapped(ctx, chain, options...)
+		return err
+	})
+	return bootstrapped, err
+}
+
+func (c *multiClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		chainID, err = c.clients[i].ChainID(ctx)
+		return err
+	})
+	return chainID, err
+}
+
+func (c *multiClient) BlockByHash(ctx context.Context, hash ethcommon.Hash) (block *ethtypes.Block, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		block, err = c.clients[i].BlockByHash(ctx, hash)
+		return err
+	})
+	return block, err
+}
+
+func (c *multiClient) BlockByNumber(ctx context.Context, number *big.Int) (block *ethtypes.Block, err error) {
+	err = c.read(ctx, blockHeight(number), func(i int) (err error) {
+		block, err = c.clients[i].BlockByNumber(ctx, number)
+		return err
+	})
+	return block, err
+}
+
+func (c *multiClient) HeaderByHash(ctx context.Context, hash ethcommon.Hash) (header *ethtypes.Header, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		header, err = c.clients[i].HeaderByHash(ctx, hash)
+		return err
+	})
+	return header, err
+}
+
+func (c *multiClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *ethtypes.Header, err error) {
+	err = c.read(ctx, blockHeight(number), func(i int) (err error) {
+		header, err = c.clients[i].HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+func (c *multiClient) TransactionByHash(ctx context.Context, hash ethcommon.Hash) (tx *ethtypes.Transaction, pending bool, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		tx, pending, err = c.clients[i].TransactionByHash(ctx, hash)
+		return err
+	})
+	return tx, pending, err
+}
+
+func (c *multiClient) TransactionReceipt(ctx context.Context, hash ethcommon.Hash) (receipt *ethtypes.Receipt, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		receipt, err = c.clients[i].TransactionReceipt(ctx, hash)
+		return err
+	})
+	return receipt, err
+}
+
+func (c *multiClient) TransactionReceipts(ctx context.Context, hashes []ethcommon.Hash) (receipts []*ethtypes.Receipt, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		receipts, err = c.clients[i].TransactionReceipts(ctx, hashes)
+		return err
+	})
+	return receipts, err
+}
+
+func (c *multiClient) TraceTransaction(ctx context.Context, hash string) (call *Call, flatCalls []*FlatCall, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		call, flatCalls, err = c.clients[i].TraceTransaction(ctx, hash)
+		return err
+	})
+	return call, flatCalls, err
+}
+
+func (c *multiClient) TraceBlockByHash(ctx context.Context, hash string) (calls []*Call, flatCalls [][]*FlatCall, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		calls, flatCalls, err = c.clients[i].TraceBlockByHash(ctx, hash)
+		return err
+	})
+	return calls, flatCalls, err
+}
+
+func (c *multiClient) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
+	return c.clients[c.healthiest()].SendTransaction(ctx, tx)
+}
+
+func (c *multiClient) BalanceAt(ctx context.Context, account ethcommon.Address, number *big.Int) (balance *big.Int, err error) {
+	err = c.read(ctx, blockHeight(number), func(i int) (err error) {
+		balance, err = c.clients[i].BalanceAt(ctx, account, number)
+		return err
+	})
+	return balance, err
+}
+
+func (c *multiClient) NonceAt(ctx context.Context, account ethcommon.Address, number *big.Int) (nonce uint64, err error) {
+	err = c.read(ctx, blockHeight(number), func(i int) (err error) {
+		nonce, err = c.clients[i].NonceAt(ctx, account, number)
+		return err
+	})
+	return nonce, err
+}
+
+func (c *multiClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		price, err = c.clients[i].SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+func (c *multiClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		tipCap, err = c.clients[i].SuggestGasTipCap(ctx)
+		return err
+	})
+	return tipCap, err
+}
+
+func (c *multiClient) EstimateGas(ctx context.Context, msg interfaces.CallMsg) (gas uint64, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		gas, err = c.clients[i].EstimateGas(ctx, msg)
+		return err
+	})
+	return gas, err
+}
+
+func (c *multiClient) TxPoolContent(ctx context.Context) (content *TxPoolContent, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		content, err = c.clients[i].TxPoolContent(ctx)
+		return err
+	})
+	return content, err
+}
+
+func (c *multiClient) GetNetworkName(ctx context.Context, options ...rpc.Option) (name string, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		name, err = c.clients[i].GetNetworkName(ctx, options...)
+		return err
+	})
+	return name, err
+}
+
+func (c *multiClient) Peers(ctx context.Context, options ...rpc.Option) (peers []info.Peer, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		peers, err = c.clients[i].Peers(ctx, options...)
+		return err
+	})
+	return peers, err
+}
+
+func (c *multiClient) GetContractInfo(addr ethcommon.Address, erc20 bool) (symbol string, decimals uint8, err error) {
+	err = c.read(context.Background(), 0, func(i int) (err error) {
+		symbol, decimals, err = c.clients[i].GetContractInfo(addr, erc20)
+		return err
+	})
+	return symbol, decimals, err
+}
+
+func (c *multiClient) CallContract(ctx context.Context, msg interfaces.CallMsg, number *big.Int) (result []byte, err error) {
+	err = c.read(ctx, blockHeight(number), func(i int) (err error) {
+		result, err = c.clients[i].CallContract(ctx, msg, number)
+		return err
+	})
+	return result, err
+}
+
+func (c *multiClient) GetNetworkID(ctx context.Context, options ...rpc.Option) (networkID uint32, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		networkID, err = c.clients[i].GetNetworkID(ctx, options...)
+		return err
+	})
+	return networkID, err
+}
+
+func (c *multiClient) GetBlockchainID(ctx context.Context, alias string, options ...rpc.Option) (blockchainID ids.ID, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		blockchainID, err = c.clients[i].GetBlockchainID(ctx, alias, options...)
+		return err
+	})
+	return blockchainID, err
+}
+
+func (c *multiClient) IssueTx(ctx context.Context, txBytes []byte) (ids.ID, error) {
+	return c.clients[c.healthiest()].IssueTx(ctx, txBytes)
+}
+
+func (c *multiClient) GetAtomicUTXOs(
+	ctx context.Context,
+	addrs []string,
+	sourceChain string,
+	limit uint32,
+	startAddress, startUTXOID string,
+) (utxos [][]byte, index api.Index, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		utxos, index, err = c.clients[i].GetAtomicUTXOs(ctx, addrs, sourceChain, limit, startAddress, startUTXOID)
+		return err
+	})
+	return utxos, index, err
+}
+
+func (c *multiClient) EstimateBaseFee(ctx context.Context) (baseFee *big.Int, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		baseFee, err = c.clients[i].EstimateBaseFee(ctx)
+		return err
+	})
+	return baseFee, err
+}
+
+type multiPChainClient struct {
+	*nodeSet
+	clients []PChainClient
+}
+
+// NewMultiPChainClient returns a PChainClient routing calls between
+// [clients] the same way as NewMultiClient
+func NewMultiPChainClient(ctx context.Context, name string, clients []PChainClient) PChainClient {
+	checks := make([]nodeCheck, len(clients))
+	for i, c := range clients {
+		c := c
+		checks[i] = func(ctx context.Context) (bool, uint64, error) {
+			bootstrapped, err := c.IsBootstrapped(ctx, "P")
+			if err != nil || !bootstrapped {
+				return false, 0, err
+			}
+
+			height, err := c.GetHeight(ctx)
+			if err != nil {
+				return false, 0, err
+			}
+			return true, height, nil
+		}
+	}
+
+	return &multiPChainClient{
+		nodeSet: newNodeSet(ctx, name, checks),
+		clients: clients,
+	}
+}
+
+func (c *multiPChainClient) GetContainerByIndex(ctx context.Context, index uint64, options ...rpc.Option) (container indexer.Container, err error) {
+	// Container indices follow block heights
+	err = c.read(ctx, index, func(i int) (err error) {
+		container, err = c.clients[i].GetContainerByIndex(ctx, index, options...)
+		return err
+	})
+	return container, err
+}
+
+func (c *multiPChainClient) GetContainerByID(ctx context.Context, containerID ids.ID, options ...rpc.Option) (container indexer.Container, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		container, err = c.clients[i].GetContainerByID(ctx, containerID, options...)
+		return err
+	})
+	return container, err
+}
+
+func (c *multiPChainClient) GetLastAccepted(ctx context.Context, options ...rpc.Option) (container indexer.Container, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		container, err = c.clients[i].GetLastAccepted(ctx, options...)
+		return err
+	})
+	return container, err
+}
+
+func (c *multiPChainClient) GetUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (utxos [][]byte, endAddress ids.ShortID, endUTXOID ids.ID, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		utxos, endAddress, endUTXOID, err = c.clients[i].GetUTXOs(ctx, addrs, limit, startAddress, startUTXOID, options...)
+		return err
+	})
+	return utxos, endAddress, endUTXOID, err
+}
+
+func (c *multiPChainClient) GetAtomicUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	sourceChain string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (utxos [][]byte, endAddress ids.ShortID, endUTXOID ids.ID, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		utxos, endAddress, endUTXOID, err = c.clients[i].GetAtomicUTXOs(ctx, addrs, sourceChain, limit, startAddress, startUTXOID, options...)
+		return err
+	})
+	return utxos, endAddress, endUTXOID, err
+}
+
+func (c *multiPChainClient) GetRewardUTXOs(ctx context.Context, args *api.GetTxArgs, options ...rpc.Option) (utxos [][]byte, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		utxos, err = c.clients[i].GetRewardUTXOs(ctx, args, options...)
+		return err
+	})
+	return utxos, err
+}
+
+func (c *multiPChainClient) GetHeight(ctx context.Context, options ...rpc.Option) (height uint64, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		height, err = c.clients[i].GetHeight(ctx, options...)
+		return err
+	})
+	return height, err
+}
+
+func (c *multiPChainClient) GetBalance(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (balance *platformvm.GetBalanceResponse, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		balance, err = c.clients[i].GetBalance(ctx, addrs, options...)
+		return err
+	})
+	return balance, err
+}
+
+func (c *multiPChainClient) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) (txBytes []byte, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		txBytes, err = c.clients[i].GetTx(ctx, txID, options...)
+		return err
+	})
+	return txBytes, err
+}
+
+func (c *multiPChainClient) GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) (blockBytes []byte, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		blockBytes, err = c.clients[i].GetBlock(ctx, blockID, options...)
+		return err
+	})
+	return blockBytes, err
+}
+
+func (c *multiPChainClient) IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (ids.ID, error) {
+	return c.clients[c.healthiest()].IssueTx(ctx, tx, options...)
+}
+
+func (c *multiPChainClient) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (status *platformvm.GetTxStatusResponse, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		status, err = c.clients[i].GetTxStatus(ctx, txID, options...)
+		return err
+	})
+	return status, err
+}
+
+func (c *multiPChainClient) GetStake(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (staked map[ids.ID]uint64, stakedOuts [][]byte, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		staked, stakedOuts, err = c.clients[i].GetStake(ctx, addrs, options...)
+		return err
+	})
+	return staked, stakedOuts, err
+}
+
+func (c *multiPChainClient) GetCurrentValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) (validators []platformvm.ClientPermissionlessValidator, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		validators, err = c.clients[i].GetCurrentValidators(ctx, subnetID, nodeIDs, options...)
+		return err
+	})
+	return validators, err
+}
+
+func (c *multiPChainClient) GetPendingValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) (validators []interface{}, delegators []interface{}, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		validators, delegators, err = c.clients[i].GetPendingValidators(ctx, subnetID, nodeIDs, options...)
+		return err
+	})
+	return validators, delegators, err
+}
+
+func (c *multiPChainClient) GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (description *avm.GetAssetDescriptionReply, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		description, err = c.clients[i].GetAssetDescription(ctx, assetID, options...)
+		return err
+	})
+	return description, err
+}
+
+func (c *multiPChainClient) IsBootstrapped(ctx context.Context, chain string, options ...rpc.Option) (bootstrapped bool, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		bootstrapped, err = c.clients[i].IsBootstrapped(ctx, chain, options...)
+		return err
+	})
+	return bootstrapped, err
+}
+
+func (c *multiPChainClient) Peers(ctx context.Context, options ...rpc.Option) (peers []info.Peer, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		peers, err = c.clients[i].Peers(ctx, options...)
+		return err
+	})
+	return peers, err
+}
+
+func (c *multiPChainClient) GetNodeID(ctx context.Context, options ...rpc.Option) (nodeID ids.NodeID, pop *signer.ProofOfPossession, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		nodeID, pop, err = c.clients[i].GetNodeID(ctx, options...)
+		return err
+	})
+	return nodeID, pop, err
+}
+
+func (c *multiPChainClient) GetNetworkID(ctx context.Context, options ...rpc.Option) (networkID uint32, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		networkID, err = c.clients[i].GetNetworkID(ctx, options...)
+		return err
+	})
+	return networkID, err
+}
+
+func (c *multiPChainClient) GetBlockchainID(ctx context.Context, alias string, options ...rpc.Option) (blockchainID ids.ID, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		blockchainID, err = c.clients[i].GetBlockchainID(ctx, alias, options...)
+		return err
+	})
+	return blockchainID, err
+}
+
+func (c *multiPChainClient) GetTxFee(ctx context.Context, options ...rpc.Option) (fee *info.GetTxFeeResponse, err error) {
+	err = c.read(ctx, 0, func(i int) (err error) {
+		fee, err = c.clients[i].GetTxFee(ctx, options...)
+		return err
+	})
+	return fee, err
+}
@@ -0,0 +1,74 @@
+package client_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/interfaces"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+)
+
+// newNodeMock returns a node at [height], which health checks may query
+func newNodeMock(height int64) *mocks.Client {
+	node := &mocks.Client{}
+	node.On("IsBootstrapped", mock.Anything, "C").Return(true, nil).Maybe()
+	node.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&ethtypes.Header{Number: big.NewInt(height)}, nil).Maybe()
+	return node
+}
+
+func TestMultiClientHashReads(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blockHash := ethcommon.HexToHash("0x1")
+	txHash := ethcommon.HexToHash("0x2")
+	header := &ethtypes.Header{Number: big.NewInt(100)}
+	receipt := &ethtypes.Receipt{TxHash: txHash}
+
+	// Node 0 has not seen the block yet
+	lagging := newNodeMock(100)
+	lagging.On("HeaderByHash", mock.Anything, blockHash).Return(nil, interfaces.NotFound)
+	lagging.On("TransactionReceipt", mock.Anything, txHash).Return(nil, interfaces.NotFound)
+	synced := newNodeMock(100)
+	synced.On("HeaderByHash", mock.Anything, blockHash).Return(header, nil)
+	synced.On("TransactionReceipt", mock.Anything, txHash).Return(receipt, nil)
+
+	c := client.NewMultiClient(ctx, "C", []client.Client{lagging, synced})
+
+	t.Run("not found answers fall back to the other nodes", func(t *testing.T) {
+		result, err := c.HeaderByHash(ctx, blockHash)
+		assert.NoError(t, err)
+		assert.Equal(t, header, result)
+
+		resultReceipt, err := c.TransactionReceipt(ctx, txHash)
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, resultReceipt)
+	})
+
+	t.Run("pinned reads stay on the node having the data", func(t *testing.T) {
+		pinnedCtx := client.WithPinnedNode(ctx)
+		_, err := c.HeaderByHash(pinnedCtx, blockHash)
+		assert.NoError(t, err)
+
+		resultReceipt, err := c.TransactionReceipt(pinnedCtx, txHash)
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, resultReceipt)
+		lagging.AssertNotCalled(t, "TransactionReceipt", pinnedCtx, txHash)
+	})
+
+	t.Run("data missing on all nodes is not found", func(t *testing.T) {
+		missingHash := ethcommon.HexToHash("0x3")
+		lagging.On("TransactionReceipt", mock.Anything, missingHash).Return(nil, interfaces.NotFound).Once()
+		synced.On("TransactionReceipt", mock.Anything, missingHash).Return(nil, interfaces.NotFound).Once()
+
+		_, err := c.TransactionReceipt(ctx, missingHash)
+		assert.ErrorIs(t, err, interfaces.NotFound)
+	})
+}
@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/coreth/interfaces"
+)
+
+const (
+	// nodeCheckInterval is the delay between two health checks of the nodes
+	nodeCheckInterval = 5 * time.Second
+	nodeCheckTimeout  = 5 * time.Second
+)
+
+var errNoNodes = errors.New("no avalanche node is configured")
+
+// nodeStatus is the result of the last health check of a node
+type nodeStatus struct {
+	healthy bool
+	height  uint64
+}
+
+// nodeCheck returns whether a node is bootstrapped and its latest height
+type nodeCheck func(ctx context.Context) (bool, uint64, error)
+
+// nodeSet routes calls between nodes based on their health and height.
+// Nodes are considered healthy until their first health check completes.
+type nodeSet struct {
+	name   string
+	checks []nodeCheck
+
+	lock     sync.RWMutex
+	statuses []nodeStatus
+}
+
+func newNodeSet(ctx context.Context, name string, checks []nodeCheck) *nodeSet {
+	s := &nodeSet{
+		name:     name,
+		checks:   checks,
+		statuses: make([]nodeStatus, len(checks)),
+	}
+	for i := range s.statuses {
+		s.statuses[i].healthy = true
+	}
+
+	go s.run(ctx)
+	return s
+}
+
+func (s *nodeSet) run(ctx context.Context) {
+	ticker := time.NewTicker(nodeCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		s.checkAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *nodeSet) checkAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := range s.checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.check(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func (s *nodeSet) check(ctx context.Context, i int) {
+	ctx, cancel := context.WithTimeout(ctx, nodeCheckTimeout)
+	defer cancel()
+
+	bootstrapped, height, err := s.checks[i](ctx)
+	status := nodeStatus{healthy: err == nil && bootstrapped, height: height}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if status.healthy != s.statuses[i].healthy {
+		switch {
+		case err != nil:
+			log.Printf("%s node %d is unhealthy: %v", s.name, i, err)
+		case !bootstrapped:
+			log.Printf("%s node %d is not bootstrapped", s.name, i)
+		default:
+			log.Printf("%s node %d is healthy", s.name, i)
+		}
+	}
+	if !status.healthy && err != nil {
+		// Keep the last known height of unreachable nodes
+		status.height = s.statuses[i].height
+	}
+	s.statuses[i] = status
+}
+
+// order returns the nodes to try for a call, starting with the healthy nodes
+// having reached [minHeight], highest first, then the other healthy nodes,
+// then the unhealthy ones as a last resort
+func (s *nodeSet) order(minHeight uint64) []int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	rank := func(status nodeStatus) int {
+		switch {
+		case status.healthy && status.height >= minHeight:
+			return 0
+		case status.healthy:
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	order := make([]int, len(s.statuses))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := s.statuses[order[i]], s.statuses[order[j]]
+		if rank(a) != rank(b) {
+			return rank(a) < rank(b)
+		}
+		return a.height > b.height
+	})
+	return order
+}
+
+// healthiest returns the node to which transactions are submitted
+func (s *nodeSet) healthiest() int {
+	return s.order(0)[0]
+}
+
+// pinKey is the context key of the node pinned by WithPinnedNode
+type pinKey struct{}
+
+// pin is the node to which the reads of a context are routed, once known
+type pin struct {
+	lock   sync.Mutex
+	node   int
+	pinned bool
+}
+
+// WithPinnedNode returns a context routing all the reads made with it to the
+// same node, the first one answering, so that a sequence of reads such as
+// paginated calls sees the state of a single node
+func WithPinnedNode(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pinKey{}, &pin{})
+}
+
+func (p *pin) get() (int, bool) {
+	if p == nil {
+		return 0, false
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.node, p.pinned
+}
+
+// set pins [node], unless a node is already pinned
+func (p *pin) set(node int) {
+	if p == nil {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if !p.pinned {
+		p.node, p.pinned = node, true
+	}
+}
+
+// read calls [fn] on the nodes in order until one answers. Unreachable node
+// errors fall back to the next node, as do not found errors since a node
+// lagging behind may not have the requested data yet. Other errors are
+// returned as is. When [ctx] is pinned to a node, only this node is called.
+func (s *nodeSet) read(ctx context.Context, minHeight uint64, fn func(i int) error) error {
+	p, _ := ctx.Value(pinKey{}).(*pin)
+	if node, ok := p.get(); ok {
+		return fn(node)
+	}
+
+	var firstErr, notFoundErr error
+	for _, i := range s.order(minHeight) {
+		err := fn(i)
+		switch {
+		case errors.Is(err, interfaces.NotFound):
+			if notFoundErr == nil {
+				notFoundErr = err
+			}
+		case isTransient(err):
+			if firstErr == nil {
+				firstErr = err
+			}
+		default:
+			p.set(i)
+			return err
+		}
+	}
+
+	if notFoundErr != nil {
+		return notFoundErr
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	return errNoNodes
+}
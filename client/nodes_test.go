@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeSet(t *testing.T) {
+	ctx := context.Background()
+	checkErr := fmt.Errorf("failed to issue request: %w", io.EOF)
+
+	heights := []uint64{100, 120, 110}
+	errs := []error{nil, nil, nil}
+	bootstrapped := []bool{true, true, true}
+
+	s := &nodeSet{name: "C", statuses: make([]nodeStatus, 3)}
+	for i := range heights {
+		i := i
+		s.checks = append(s.checks, func(context.Context) (bool, uint64, error) {
+			return bootstrapped[i], heights[i], errs[i]
+		})
+	}
+
+	t.Run("nodes are ordered by health and height", func(t *testing.T) {
+		s.checkAll(ctx)
+		assert.Equal(t, []int{1, 2, 0}, s.order(0))
+		assert.Equal(t, []int{1, 2, 0}, s.order(105))
+		assert.Equal(t, 1, s.healthiest())
+
+		errs[1] = checkErr
+		bootstrapped[2] = false
+		s.checkAll(ctx)
+		assert.Equal(t, []int{0, 1, 2}, s.order(0))
+		assert.Equal(t, 0, s.healthiest())
+		assert.Equal(t, uint64(120), s.statuses[1].height)
+
+		errs[1] = nil
+		bootstrapped[2] = true
+		s.checkAll(ctx)
+	})
+
+	t.Run("reads are routed to nodes having the block", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 0}, s.order(115))
+
+		heights[1] = 90
+		s.checkAll(ctx)
+		assert.Equal(t, []int{2, 0, 1}, s.order(105))
+		assert.Equal(t, []int{2, 0, 1}, s.order(115))
+		heights[1] = 120
+		s.checkAll(ctx)
+	})
+
+	t.Run("reads fall back on errors", func(t *testing.T) {
+		tried := []int{}
+		err := s.read(ctx, 0, func(i int) error {
+			tried = append(tried, i)
+			if i == 1 {
+				return checkErr
+			}
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1, 2}, tried)
+
+		notFound := errors.New("not found")
+		tried = []int{}
+		err = s.read(ctx, 0, func(i int) error {
+			tried = append(tried, i)
+			if i == 2 {
+				return notFound
+			}
+			return checkErr
+		})
+		assert.ErrorIs(t, err, notFound)
+		assert.Equal(t, []int{1, 2}, tried)
+
+		err = s.read(ctx, 0, func(i int) error {
+			return checkErr
+		})
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("pinned reads are routed to a single node", func(t *testing.T) {
+		pinnedCtx := WithPinnedNode(ctx)
+		tried := []int{}
+		err := s.read(pinnedCtx, 0, func(i int) error {
+			tried = append(tried, i)
+			if i == 1 {
+				return checkErr
+			}
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1, 2}, tried)
+
+		// Later reads stick to the node which answered, even when it fails
+		tried = []int{}
+		err = s.read(pinnedCtx, 0, func(i int) error {
+			tried = append(tried, i)
+			return checkErr
+		})
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, []int{2}, tried)
+	})
+}
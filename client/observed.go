@@ -0,0 +1,425 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/interfaces"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Interface compliance
+var (
+	_ Client       = &observedClient{}
+	_ PChainClient = &observedPChainClient{}
+	_ XChainClient = &observedXChainClient{}
+)
+
+// CallObserver is notified of the duration and outcome of upstream calls
+type CallObserver interface {
+	ObserveCall(client string, method string, duration time.Duration, err error)
+}
+
+type callObserver struct {
+	name     string
+	observer CallObserver
+}
+
+func (o callObserver) observe(method string, start time.Time, err *error) {
+	o.observer.ObserveCall(o.name, method, time.Since(start), *err)
+}
+
+type observedClient struct {
+	Client
+	callObserver
+}
+
+// NewObservedClient returns a Client reporting all calls to [observer] under [name]
+func NewObservedClient(c Client, name string, observer CallObserver) Client {
+	return &observedClient{
+		Client:       c,
+		callObserver: callObserver{name: name, observer: observer},
+	}
+}
+
+func (c *observedClient) IsBootstrapped(ctx context.Context, chain string, options ...rpc.Option) (_ bool, err error) {
+	defer c.observe("IsBootstrapped", time.Now(), &err)
+	return c.Client.IsBootstrapped(ctx, chain, options...)
+}
+
+func (c *observedClient) ChainID(ctx context.Context) (_ *big.Int, err error) {
+	defer c.observe("ChainID", time.Now(), &err)
+	return c.Client.ChainID(ctx)
+}
+
+func (c *observedClient) BlockByHash(ctx context.Context, hash ethcommon.Hash) (_ *ethtypes.Block, err error) {
+	defer c.observe("BlockByHash", time.Now(), &err)
+	return c.Client.BlockByHash(ctx, hash)
+}
+
+func (c *observedClient) BlockByNumber(ctx context.Context, number *big.Int) (_ *ethtypes.Block, err error) {
+	defer c.observe("BlockByNumber", time.Now(), &err)
+	return c.Client.BlockByNumber(ctx, number)
+}
+
+func (c *observedClient) HeaderByHash(ctx context.Context, hash ethcommon.Hash) (_ *ethtypes.Header, err error) {
+	defer c.observe("HeaderByHash", time.Now(), &err)
+	return c.Client.HeaderByHash(ctx, hash)
+}
+
+func (c *observedClient) HeaderByNumber(ctx context.Context, number *big.Int) (_ *ethtypes.Header, err error) {
+	defer c.observe("HeaderByNumber", time.Now(), &err)
+	return c.Client.HeaderByNumber(ctx, number)
+}
+
+func (c *observedClient) TransactionByHash(ctx context.Context, hash ethcommon.Hash) (_ *ethtypes.Transaction, _ bool, err error) {
+	defer c.observe("TransactionByHash", time.Now(), &err)
+	return c.Client.TransactionByHash(ctx, hash)
+}
+
+func (c *observedClient) TransactionReceipt(ctx context.Context, hash ethcommon.Hash) (_ *ethtypes.Receipt, err error) {
+	defer c.observe("TransactionReceipt", time.Now(), &err)
+	return c.Client.TransactionReceipt(ctx, hash)
+}
+
+func (c *observedClient) TransactionReceipts(ctx context.Context, hashes []ethcommon.Hash) (_ []*ethtypes.Receipt, err error) {
+	defer c.observe("TransactionReceipts", time.Now(), &err)
+	return c.Client.TransactionReceipts(ctx, hashes)
+}
+
+func (c *observedClient) TraceTransaction(ctx context.Context, hash string) (_ *Call, _ []*FlatCall, err error) {
+	defer c.observe("TraceTransaction", time.Now(), &err)
+	return c.Client.TraceTransaction(ctx, hash)
+}
+
+func (c *observedClient) TraceBlockByHash(ctx context.Context, hash string) (_ []*Call, _ [][]*FlatCall, err error) {
+	defer c.observe("TraceBlockByHash", time.Now(), &err)
+	return c.Client.TraceBlockByHash(ctx, hash)
+}
+
+func (c *observedClient) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) (err error) {
+	defer c.observe("SendTransaction", time.Now(), &err)
+	return c.Client.SendTransaction(ctx, tx)
+}
+
+func (c *observedClient) BalanceAt(ctx context.Context, account ethcommon.Address, number *big.Int) (_ *big.Int, err error) {
+	defer c.observe("BalanceAt", time.Now(), &err)
+	return c.Client.BalanceAt(ctx, account, number)
+}
+
+func (c *observedClient) NonceAt(ctx context.Context, account ethcommon.Address, number *big.Int) (_ uint64, err error) {
+	defer c.observe("NonceAt", time.Now(), &err)
+	return c.Client.NonceAt(ctx, account, number)
+}
+
+func (c *observedClient) SuggestGasPrice(ctx context.Context) (_ *big.Int, err error) {
+	defer c.observe("SuggestGasPrice", time.Now(), &err)
+	return c.Client.SuggestGasPrice(ctx)
+}
+
+func (c *observedClient) SuggestGasTipCap(ctx context.Context) (_ *big.Int, err error) {
+	defer c.observe("SuggestGasTipCap", time.Now(), &err)
+	return c.Client.SuggestGasTipCap(ctx)
+}
+
+func (c *observedClient) EstimateGas(ctx context.Context, msg interfaces.CallMsg) (_ uint64, err error) {
+	defer c.observe("EstimateGas", time.Now(), &err)
+	return c.Client.EstimateGas(ctx, msg)
+}
+
+func (c *observedClient) TxPoolContent(ctx context.Context) (_ *TxPoolContent, err error) {
+	defer c.observe("TxPoolContent", time.Now(), &err)
+	return c.Client.TxPoolContent(ctx)
+}
+
+func (c *observedClient) GetNetworkName(ctx context.Context, options ...rpc.Option) (_ string, err error) {
+	defer c.observe("GetNetworkName", time.Now(), &err)
+	return c.Client.GetNetworkName(ctx, options...)
+}
+
+func (c *observedClient) Peers(ctx context.Context, options ...rpc.Option) (_ []info.Peer, err error) {
+	defer c.observe("Peers", time.Now(), &err)
+	return c.Client.Peers(ctx, options...)
+}
+
+func (c *observedClient) GetContractInfo(addr ethcommon.Address, erc20 bool) (_ string, _ uint8, err error) {
+	defer c.observe("GetContractInfo", time.Now(), &err)
+	return c.Client.GetContractInfo(addr, erc20)
+}
+
+func (c *observedClient) CallContract(ctx context.Context, msg interfaces.CallMsg, number *big.Int) (_ []byte, err error) {
+	defer c.observe("CallContract", time.Now(), &err)
+	return c.Client.CallContract(ctx, msg, number)
+}
+
+func (c *observedClient) GetNetworkID(ctx context.Context, options ...rpc.Option) (_ uint32, err error) {
+	defer c.observe("GetNetworkID", time.Now(), &err)
+	return c.Client.GetNetworkID(ctx, options...)
+}
+
+func (c *observedClient) GetBlockchainID(ctx context.Context, alias string, options ...rpc.Option) (_ ids.ID, err error) {
+	defer c.observe("GetBlockchainID", time.Now(), &err)
+	return c.Client.GetBlockchainID(ctx, alias, options...)
+}
+
+func (c *observedClient) IssueTx(ctx context.Context, txBytes []byte) (_ ids.ID, err error) {
+	defer c.observe("IssueTx", time.Now(), &err)
+	return c.Client.IssueTx(ctx, txBytes)
+}
+
+func (c *observedClient) GetAtomicUTXOs(
+	ctx context.Context,
+	addrs []string,
+	sourceChain string,
+	limit uint32,
+	startAddress, startUTXOID string,
+) (_ [][]byte, _ api.Index, err error) {
+	defer c.observe("GetAtomicUTXOs", time.Now(), &err)
+	return c.Client.GetAtomicUTXOs(ctx, addrs, sourceChain, limit, startAddress, startUTXOID)
+}
+
+func (c *observedClient) EstimateBaseFee(ctx context.Context) (_ *big.Int, err error) {
+	defer c.observe("EstimateBaseFee", time.Now(), &err)
+	return c.Client.EstimateBaseFee(ctx)
+}
+
+type observedPChainClient struct {
+	PChainClient
+	callObserver
+}
+
+// NewObservedPChainClient returns a PChainClient reporting all calls to [observer] under [name]
+func NewObservedPChainClient(c PChainClient, name string, observer CallObserver) PChainClient {
+	return &observedPChainClient{
+		PChainClient: c,
+		callObserver: callObserver{name: name, observer: observer},
+	}
+}
+
+func (c *observedPChainClient) GetContainerByIndex(ctx context.Context, index uint64, options ...rpc.Option) (_ indexer.Container, err error) {
+	defer c.observe("GetContainerByIndex", time.Now(), &err)
+	return c.PChainClient.GetContainerByIndex(ctx, index, options...)
+}
+
+func (c *observedPChainClient) GetContainerByID(ctx context.Context, containerID ids.ID, options ...rpc.Option) (_ indexer.Container, err error) {
+	defer c.observe("GetContainerByID", time.Now(), &err)
+	return c.PChainClient.GetContainerByID(ctx, containerID, options...)
+}
+
+func (c *observedPChainClient) GetLastAccepted(ctx context.Context, options ...rpc.Option) (_ indexer.Container, err error) {
+	defer c.observe("GetLastAccepted", time.Now(), &err)
+	return c.PChainClient.GetLastAccepted(ctx, options...)
+}
+
+func (c *observedPChainClient) GetUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (_ [][]byte, _ ids.ShortID, _ ids.ID, err error) {
+	defer c.observe("GetUTXOs", time.Now(), &err)
+	return c.PChainClient.GetUTXOs(ctx, addrs, limit, startAddress, startUTXOID, options...)
+}
+
+func (c *observedPChainClient) GetAtomicUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	sourceChain string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (_ [][]byte, _ ids.ShortID, _ ids.ID, err error) {
+	defer c.observe("GetAtomicUTXOs", time.Now(), &err)
+	return c.PChainClient.GetAtomicUTXOs(ctx, addrs, sourceChain, limit, startAddress, startUTXOID, options...)
+}
+
+func (c *observedPChainClient) GetRewardUTXOs(ctx context.Context, args *api.GetTxArgs, options ...rpc.Option) (_ [][]byte, err error) {
+	defer c.observe("GetRewardUTXOs", time.Now(), &err)
+	return c.PChainClient.GetRewardUTXOs(ctx, args, options...)
+}
+
+func (c *observedPChainClient) GetHeight(ctx context.Context, options ...rpc.Option) (_ uint64, err error) {
+	defer c.observe("GetHeight", time.Now(), &err)
+	return c.PChainClient.GetHeight(ctx, options...)
+}
+
+func (c *observedPChainClient) GetBalance(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (_ *platformvm.GetBalanceResponse, err error) {
+	defer c.observe("GetBalance", time.Now(), &err)
+	return c.PChainClient.GetBalance(ctx, addrs, options...)
+}
+
+func (c *observedPChainClient) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) (_ []byte, err error) {
+	defer c.observe("GetTx", time.Now(), &err)
+	return c.PChainClient.GetTx(ctx, txID, options...)
+}
+
+func (c *observedPChainClient) GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) (_ []byte, err error) {
+	defer c.observe("GetBlock", time.Now(), &err)
+	return c.PChainClient.GetBlock(ctx, blockID, options...)
+}
+
+func (c *observedPChainClient) IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (_ ids.ID, err error) {
+	defer c.observe("IssueTx", time.Now(), &err)
+	return c.PChainClient.IssueTx(ctx, tx, options...)
+}
+
+func (c *observedPChainClient) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (_ *platformvm.GetTxStatusResponse, err error) {
+	defer c.observe("GetTxStatus", time.Now(), &err)
+	return c.PChainClient.GetTxStatus(ctx, txID, options...)
+}
+
+func (c *observedPChainClient) GetStake(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (_ map[ids.ID]uint64, _ [][]byte, err error) {
+	defer c.observe("GetStake", time.Now(), &err)
+	return c.PChainClient.GetStake(ctx, addrs, options...)
+}
+
+func (c *observedPChainClient) GetCurrentValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) (_ []platformvm.ClientPermissionlessValidator, err error) {
+	defer c.observe("GetCurrentValidators", time.Now(), &err)
+	return c.PChainClient.GetCurrentValidators(ctx, subnetID, nodeIDs, options...)
+}
+
+func (c *observedPChainClient) GetPendingValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) (_ []interface{}, _ []interface{}, err error) {
+	defer c.observe("GetPendingValidators", time.Now(), &err)
+	return c.PChainClient.GetPendingValidators(ctx, subnetID, nodeIDs, options...)
+}
+
+func (c *observedPChainClient) GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (_ *avm.GetAssetDescriptionReply, err error) {
+	defer c.observe("GetAssetDescription", time.Now(), &err)
+	return c.PChainClient.GetAssetDescription(ctx, assetID, options...)
+}
+
+func (c *observedPChainClient) IsBootstrapped(ctx context.Context, chain string, options ...rpc.Option) (_ bool, err error) {
+	defer c.observe("IsBootstrapped", time.Now(), &err)
+	return c.PChainClient.IsBootstrapped(ctx, chain, options...)
+}
+
+func (c *observedPChainClient) Peers(ctx context.Context, options ...rpc.Option) (_ []info.Peer, err error) {
+	defer c.observe("Peers", time.Now(), &err)
+	return c.PChainClient.Peers(ctx, options...)
+}
+
+func (c *observedPChainClient) GetNodeID(ctx context.Context, options ...rpc.Option) (_ ids.NodeID, _ *signer.ProofOfPossession, err error) {
+	defer c.observe("GetNodeID", time.Now(), &err)
+	return c.PChainClient.GetNodeID(ctx, options...)
+}
+
+func (c *observedPChainClient) GetNetworkID(ctx context.Context, options ...rpc.Option) (_ uint32, err error) {
+	defer c.observe("GetNetworkID", time.Now(), &err)
+	return c.PChainClient.GetNetworkID(ctx, options...)
+}
+
+func (c *observedPChainClient) GetBlockchainID(ctx context.Context, alias string, options ...rpc.Option) (_ ids.ID, err error) {
+	defer c.observe("GetBlockchainID", time.Now(), &err)
+	return c.PChainClient.GetBlockchainID(ctx, alias, options...)
+}
+
+func (c *observedPChainClient) GetTxFee(ctx context.Context, options ...rpc.Option) (_ *info.GetTxFeeResponse, err error) {
+	defer c.observe("GetTxFee", time.Now(), &err)
+	return c.PChainClient.GetTxFee(ctx, options...)
+}
+
+type observedXChainClient struct {
+	XChainClient
+	callObserver
+}
+
+// NewObservedXChainClient returns a XChainClient reporting all calls to [observer] under [name]
+func NewObservedXChainClient(c XChainClient, name string, observer CallObserver) XChainClient {
+	return &observedXChainClient{
+		XChainClient: c,
+		callObserver: callObserver{name: name, observer: observer},
+	}
+}
+
+func (c *observedXChainClient) GetContainerByIndex(ctx context.Context, index uint64, options ...rpc.Option) (_ indexer.Container, err error) {
+	defer c.observe("GetContainerByIndex", time.Now(), &err)
+	return c.XChainClient.GetContainerByIndex(ctx, index, options...)
+}
+
+func (c *observedXChainClient) GetLastAccepted(ctx context.Context, options ...rpc.Option) (_ indexer.Container, err error) {
+	defer c.observe("GetLastAccepted", time.Now(), &err)
+	return c.XChainClient.GetLastAccepted(ctx, options...)
+}
+
+func (c *observedXChainClient) GetIndex(ctx context.Context, containerID ids.ID, options ...rpc.Option) (_ uint64, err error) {
+	defer c.observe("GetIndex", time.Now(), &err)
+	return c.XChainClient.GetIndex(ctx, containerID, options...)
+}
+
+func (c *observedXChainClient) GetUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (_ [][]byte, _ ids.ShortID, _ ids.ID, err error) {
+	defer c.observe("GetUTXOs", time.Now(), &err)
+	return c.XChainClient.GetUTXOs(ctx, addrs, limit, startAddress, startUTXOID, options...)
+}
+
+func (c *observedXChainClient) GetAtomicUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	sourceChain string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (_ [][]byte, _ ids.ShortID, _ ids.ID, err error) {
+	defer c.observe("GetAtomicUTXOs", time.Now(), &err)
+	return c.XChainClient.GetAtomicUTXOs(ctx, addrs, sourceChain, limit, startAddress, startUTXOID, options...)
+}
+
+func (c *observedXChainClient) GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (_ *avm.GetAssetDescriptionReply, err error) {
+	defer c.observe("GetAssetDescription", time.Now(), &err)
+	return c.XChainClient.GetAssetDescription(ctx, assetID, options...)
+}
+
+func (c *observedXChainClient) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) (_ []byte, err error) {
+	defer c.observe("GetTx", time.Now(), &err)
+	return c.XChainClient.GetTx(ctx, txID, options...)
+}
+
+func (c *observedXChainClient) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (_ ids.ID, err error) {
+	defer c.observe("IssueTx", time.Now(), &err)
+	return c.XChainClient.IssueTx(ctx, txBytes, options...)
+}
+
+func (c *observedXChainClient) IsBootstrapped(ctx context.Context, chain string, options ...rpc.Option) (_ bool, err error) {
+	defer c.observe("IsBootstrapped", time.Now(), &err)
+	return c.XChainClient.IsBootstrapped(ctx, chain, options...)
+}
+
+func (c *observedXChainClient) Peers(ctx context.Context, options ...rpc.Option) (_ []info.Peer, err error) {
+	defer c.observe("Peers", time.Now(), &err)
+	return c.XChainClient.Peers(ctx, options...)
+}
+
+func (c *observedXChainClient) GetNetworkID(ctx context.Context, options ...rpc.Option) (_ uint32, err error) {
+	defer c.observe("GetNetworkID", time.Now(), &err)
+	return c.XChainClient.GetNetworkID(ctx, options...)
+}
+
+func (c *observedXChainClient) GetBlockchainID(ctx context.Context, alias string, options ...rpc.Option) (_ ids.ID, err error) {
+	defer c.observe("GetBlockchainID", time.Now(), &err)
+	return c.XChainClient.GetBlockchainID(ctx, alias, options...)
+}
+
+func (c *observedXChainClient) GetTxFee(ctx context.Context, options ...rpc.Option) (_ *info.GetTxFeeResponse, err error) {
+	defer c.observe("GetTxFee", time.Now(), &err)
+	return c.XChainClient.GetTxFee(ctx, options...)
+}
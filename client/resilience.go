@@ -0,0 +1,253 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	corethrpc "github.com/ava-labs/coreth/rpc"
+)
+
+// ErrUnavailable is returned without calling the node while the circuit
+// breaker considers it unhealthy
+var ErrUnavailable = errors.New("avalanche node is unavailable")
+
+// ResilienceConfig configures the timeouts, retries and circuit breaking of upstream calls
+type ResilienceConfig struct {
+	// Timeout of a single call, unless overridden in MethodTimeouts
+	Timeout time.Duration
+	// MethodTimeouts overrides Timeout by method name, such as TraceBlockByHash
+	MethodTimeouts map[string]time.Duration
+
+	// MaxRetries is the number of times failed idempotent calls are retried
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// FailureThreshold is the number of consecutive failed calls opening the
+	// circuit, which rejects calls for OpenDuration before letting one through
+	FailureThreshold int
+	OpenDuration     time.Duration
+}
+
+// DefaultResilienceConfig returns the config used for unset fields of a ResilienceConfig
+func DefaultResilienceConfig() ResilienceConfig {
+	return ResilienceConfig{
+		Timeout:          30 * time.Second,
+		MaxRetries:       3,
+		InitialBackoff:   100 * time.Millisecond,
+		MaxBackoff:       2 * time.Second,
+		FailureThreshold: 5,
+		OpenDuration:     10 * time.Second,
+	}
+}
+
+func (c ResilienceConfig) withDefaults() ResilienceConfig {
+	defaults := DefaultResilienceConfig()
+	if c.Timeout <= 0 {
+		c.Timeout = defaults.Timeout
+	}
+	if c.MaxRetries < 0 {
+		c.MaxRetries = 0
+	}
+	if c.InitialBackoff <= 0 {
+		c.InitialBackoff = defaults.InitialBackoff
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		c.MaxBackoff = c.InitialBackoff
+	}
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = defaults.FailureThreshold
+	}
+	if c.OpenDuration <= 0 {
+		c.OpenDuration = defaults.OpenDuration
+	}
+	return c
+}
+
+// ResilienceObserver is notified of retries and circuit breaker state changes
+type ResilienceObserver interface {
+	ObserveRetry(client string, method string)
+	ObserveCircuitOpen(client string, open bool)
+}
+
+type resilience struct {
+	name     string
+	config   ResilienceConfig
+	breaker  *circuitBreaker
+	observer ResilienceObserver
+}
+
+func newResilience(name string, config ResilienceConfig, observer ResilienceObserver) resilience {
+	r := resilience{
+		name:     name,
+		config:   config.withDefaults(),
+		observer: observer,
+	}
+	r.breaker = &circuitBreaker{
+		threshold:    r.config.FailureThreshold,
+		openDuration: r.config.OpenDuration,
+		onChange: func(open bool) {
+			if observer != nil {
+				observer.ObserveCircuitOpen(name, open)
+			}
+		},
+	}
+	return r
+}
+
+// call runs [fn] with the timeout of [method], retrying transient failures
+// of idempotent calls with an exponential backoff
+func (r resilience) call(ctx context.Context, method string, idempotent bool, fn func(context.Context) error) error {
+	attempts := 1
+	if idempotent {
+		attempts += r.config.MaxRetries
+	}
+
+	var err error
+	backoff := r.config.InitialBackoff
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if r.observer != nil {
+				r.observer.ObserveRetry(r.name, method)
+			}
+
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+			if backoff > r.config.MaxBackoff {
+				backoff = r.config.MaxBackoff
+			}
+		}
+
+		if !r.breaker.allow() {
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrUnavailable, err)
+			}
+			return ErrUnavailable
+		}
+
+		err = r.attempt(ctx, method, fn)
+		switch {
+		case ctx.Err() != nil:
+			// The request was canceled, which says nothing about the node
+			r.breaker.release()
+			return err
+		case !isTransient(err):
+			r.breaker.record(true)
+			return err
+		default:
+			r.breaker.record(false)
+		}
+	}
+
+	return err
+}
+
+func (r resilience) attempt(ctx context.Context, method string, fn func(context.Context) error) error {
+	timeout, ok := r.config.MethodTimeouts[method]
+	if !ok {
+		timeout = r.config.Timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return fn(ctx)
+}
+
+// isTransient returns true for errors caused by the node being unreachable
+// or failing, rather than answering the call with an error
+func isTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	if errors.Is(err, ErrUnavailable) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var httpErr corethrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500
+	}
+
+	// Avalanche API clients only report the status code of failed requests
+	return strings.HasPrefix(err.Error(), "received status code: 5")
+}
+
+// circuitBreaker opens after [threshold] consecutive failures, then lets a
+// single call through every [openDuration] until one succeeds
+type circuitBreaker struct {
+	threshold    int
+	openDuration time.Duration
+	onChange     func(open bool)
+
+	lock      sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+func (b *circuitBreaker) record(success bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	wasOpen := b.failures >= b.threshold
+	b.probing = false
+
+	if success {
+		b.failures = 0
+		if wasOpen {
+			b.onChange(false)
+		}
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.openDuration)
+		if !wasOpen {
+			b.onChange(true)
+		}
+	}
+}
+
+func (b *circuitBreaker) release() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.probing = false
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type resilienceObserverMock struct {
+	retries int
+	open    []bool
+}
+
+func (o *resilienceObserverMock) ObserveRetry(string, string) {
+	o.retries++
+}
+
+func (o *resilienceObserverMock) ObserveCircuitOpen(_ string, open bool) {
+	o.open = append(o.open, open)
+}
+
+func failing(calls *int, failures int, err error) func(context.Context) error {
+	return func(context.Context) error {
+		*calls++
+		if *calls <= failures {
+			return err
+		}
+		return nil
+	}
+}
+
+func TestResilience(t *testing.T) {
+	ctx := context.Background()
+	config := ResilienceConfig{
+		MaxRetries:       2,
+		InitialBackoff:   time.Millisecond,
+		MaxBackoff:       time.Millisecond,
+		FailureThreshold: 3,
+		OpenDuration:     50 * time.Millisecond,
+		MethodTimeouts: map[string]time.Duration{
+			"TraceBlockByHash": 10 * time.Millisecond,
+		},
+	}
+	transientErr := fmt.Errorf("failed to issue request: %w", io.EOF)
+
+	t.Run("idempotent calls are retried", func(t *testing.T) {
+		observer := &resilienceObserverMock{}
+		r := newResilience("P", config, observer)
+
+		calls := 0
+		err := r.call(ctx, "GetTx", true, failing(&calls, 2, transientErr))
+		assert.Nil(t, err)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, 2, observer.retries)
+
+		calls = 0
+		err = r.call(ctx, "GetTx", true, failing(&calls, 3, transientErr))
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("non idempotent calls and node errors are not retried", func(t *testing.T) {
+		r := newResilience("P", config, nil)
+
+		calls := 0
+		err := r.call(ctx, "IssueTx", false, failing(&calls, 1, transientErr))
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 1, calls)
+
+		calls = 0
+		nodeErr := errors.New("tx not found")
+		err = r.call(ctx, "GetTx", true, failing(&calls, 1, nodeErr))
+		assert.ErrorIs(t, err, nodeErr)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("calls time out per method", func(t *testing.T) {
+		r := newResilience("C", config, nil)
+
+		var deadline time.Time
+		err := r.call(ctx, "TraceBlockByHash", false, func(ctx context.Context) error {
+			deadline, _ = ctx.Deadline()
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.WithinDuration(t, time.Now(), deadline, time.Second)
+
+		err = r.call(ctx, "BlockByHash", false, func(ctx context.Context) error {
+			deadline, _ = ctx.Deadline()
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.WithinDuration(t, time.Now().Add(DefaultResilienceConfig().Timeout), deadline, time.Second)
+	})
+
+	t.Run("circuit opens after consecutive failures", func(t *testing.T) {
+		observer := &resilienceObserverMock{}
+		r := newResilience("P", config, observer)
+
+		calls := 0
+		err := r.call(ctx, "GetHeight", true, failing(&calls, 3, transientErr))
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, []bool{true}, observer.open)
+
+		err = r.call(ctx, "GetHeight", true, failing(&calls, 0, nil))
+		assert.ErrorIs(t, err, ErrUnavailable)
+		assert.Equal(t, 3, calls)
+
+		time.Sleep(config.OpenDuration)
+
+		err = r.call(ctx, "GetHeight", true, failing(&calls, 0, nil))
+		assert.Nil(t, err)
+		assert.Equal(t, 4, calls)
+		assert.Equal(t, []bool{true, false}, observer.open)
+	})
+
+	t.Run("canceled calls do not count as failures", func(t *testing.T) {
+		r := newResilience("P", config, nil)
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		for i := 0; i < config.FailureThreshold; i++ {
+			err := r.call(canceledCtx, "GetHeight", true, func(ctx context.Context) error {
+				return ctx.Err()
+			})
+			assert.ErrorIs(t, err, context.Canceled)
+		}
+
+		assert.True(t, r.breaker.allow())
+	})
+}
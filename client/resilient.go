@@ -0,0 +1,454 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/interfaces"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Interface compliance
+var (
+	_ Client       = &resilientClient{}
+	_ PChainClient = &resilientPChainClient{}
+)
+
+type resilientClient struct {
+	Client
+	resilience
+}
+
+// NewResilientClient returns a Client applying [config] to all calls, except
+// GetContractInfo which is cached. [observer] is optional.
+func NewResilientClient(c Client, name string, config ResilienceConfig, observer ResilienceObserver) Client {
+	return &resilientClient{
+		Client:     c,
+		resilience: newResilience(name, config, observer),
+	}
+}
+
+func (c *resilientClient) IsBootstrapped(ctx context.Context, chain string, options ...rpc.Option) (bootstrapped bool, err error) {
+	err = c.call(ctx, "IsBootstrapped", true, func(ctx context.Context) (err error) {
+		bootstrapped, err = c.Client.IsBootstrapped(ctx, chain, options...)
+		return err
+	})
+	return bootstrapped, err
+}
+
+func (c *resilientClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
+	err = c.call(ctx, "ChainID", true, func(ctx context.Context) (err error) {
+		chainID, err = c.Client.ChainID(ctx)
+		return err
+	})
+	return chainID, err
+}
+
+func (c *resilientClient) BlockByHash(ctx context.Context, hash ethcommon.Hash) (block *ethtypes.Block, err error) {
+	err = c.call(ctx, "BlockByHash", true, func(ctx context.Context) (err error) {
+		block, err = c.Client.BlockByHash(ctx, hash)
+		return err
+	})
+	return block, err
+}
+
+func (c *resilientClient) BlockByNumber(ctx context.Context, number *big.Int) (block *ethtypes.Block, err error) {
+	err = c.call(ctx, "BlockByNumber", true, func(ctx context.Context) (err error) {
+		block, err = c.Client.BlockByNumber(ctx, number)
+		return err
+	})
+	return block, err
+}
+
+func (c *resilientClient) HeaderByHash(ctx context.Context, hash ethcommon.Hash) (header *ethtypes.Header, err error) {
+	err = c.call(ctx, "HeaderByHash", true, func(ctx context.Context) (err error) {
+		header, err = c.Client.HeaderByHash(ctx, hash)
+		return err
+	})
+	return header, err
+}
+
+func (c *resilientClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *ethtypes.Header, err error) {
+	err = c.call(ctx, "HeaderByNumber", true, func(ctx context.Context) (err error) {
+		header, err = c.Client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+func (c *resilientClient) TransactionByHash(ctx context.Context, hash ethcommon.Hash) (tx *ethtypes.Transaction, pending bool, err error) {
+	err = c.call(ctx, "TransactionByHash", true, func(ctx context.Context) (err error) {
+		tx, pending, err = c.Client.TransactionByHash(ctx, hash)
+		return err
+	})
+	return tx, pending, err
+}
+
+func (c *resilientClient) TransactionReceipt(ctx context.Context, hash ethcommon.Hash) (receipt *ethtypes.Receipt, err error) {
+	err = c.call(ctx, "TransactionReceipt", true, func(ctx context.Context) (err error) {
+		receipt, err = c.Client.TransactionReceipt(ctx, hash)
+		return err
+	})
+	return receipt, err
+}
+
+func (c *resilientClient) TransactionReceipts(ctx context.Context, hashes []ethcommon.Hash) (receipts []*ethtypes.Receipt, err error) {
+	err = c.call(ctx, "TransactionReceipts", true, func(ctx context.Context) (err error) {
+		receipts, err = c.Client.TransactionReceipts(ctx, hashes)
+		return err
+	})
+	return receipts, err
+}
+
+func (c *resilientClient) TraceTransaction(ctx context.Context, hash string) (call *Call, flatCalls []*FlatCall, err error) {
+	err = c.call(ctx, "TraceTransaction", true, func(ctx context.Context) (err error) {
+		call, flatCalls, err = c.Client.TraceTransaction(ctx, hash)
+		return err
+	})
+	return call, flatCalls, err
+}
+
+func (c *resilientClient) TraceBlockByHash(ctx context.Context, hash string) (calls []*Call, flatCalls [][]*FlatCall, err error) {
+	err = c.call(ctx, "TraceBlockByHash", true, func(ctx context.Context) (err error) {
+		calls, flatCalls, err = c.Client.TraceBlockByHash(ctx, hash)
+		return err
+	})
+	return calls, flatCalls, err
+}
+
+func (c *resilientClient) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
+	return c.call(ctx, "SendTransaction", false, func(ctx context.Context) error {
+		return c.Client.SendTransaction(ctx, tx)
+	})
+}
+
+func (c *resilientClient) BalanceAt(ctx context.Context, account ethcommon.Address, number *big.Int) (balance *big.Int, err error) {
+	err = c.call(ctx, "BalanceAt", true, func(ctx context.Context) (err error) {
+		balance, err = c.Client.BalanceAt(ctx, account, number)
+		return err
+	})
+	return balance, err
+}
+
+func (c *resilientClient) NonceAt(ctx context.Context, account ethcommon.Address, number *big.Int) (nonce uint64, err error) {
+	err = c.call(ctx, "NonceAt", true, func(ctx context.Context) (err error) {
+		nonce, err = c.Client.NonceAt(ctx, account, number)
+		return err
+	})
+	return nonce, err
+}
+
+func (c *resilientClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = c.call(ctx, "SuggestGasPrice", true, func(ctx context.Context) (err error) {
+		price, err = c.Client.SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+func (c *resilientClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
+	err = c.call(ctx, "SuggestGasTipCap", true, func(ctx context.Context) (err error) {
+		tipCap, err = c.Client.SuggestGasTipCap(ctx)
+		return err
+	})
+	return tipCap, err
+}
+
+func (c *resilientClient) EstimateGas(ctx context.Context, msg interfaces.CallMsg) (gas uint64, err error) {
+	err = c.call(ctx, "EstimateGas", true, func(ctx context.Context) (err error) {
+		gas, err = c.Client.EstimateGas(ctx, msg)
+		return err
+	})
+	return gas, err
+}
+
+func (c *resilientClient) TxPoolContent(ctx context.Context) (content *TxPoolContent, err error) {
+	err = c.call(ctx, "TxPoolContent", true, func(ctx context.Context) (err error) {
+		content, err = c.Client.TxPoolContent(ctx)
+		return err
+	})
+	return content, err
+}
+
+func (c *resilientClient) GetNetworkName(ctx context.Context, options ...rpc.Option) (name string, err error) {
+	err = c.call(ctx, "GetNetworkName", true, func(ctx context.Context) (err error) {
+		name, err = c.Client.GetNetworkName(ctx, options...)
+		return err
+	})
+	return name, err
+}
+
+func (c *resilientClient) Peers(ctx context.Context, options ...rpc.Option) (peers []info.Peer, err error) {
+	err = c.call(ctx, "Peers", true, func(ctx context.Context) (err error) {
+		peers, err = c.Client.Peers(ctx, options...)
+		return err
+	})
+	return peers, err
+}
+
+func (c *resilientClient) CallContract(ctx context.Context, msg interfaces.CallMsg, number *big.Int) (result []byte, err error) {
+	err = c.call(ctx, "CallContract", true, func(ctx context.Context) (err error) {
+		result, err = c.Client.CallContract(ctx, msg, number)
+		return err
+	})
+	return result, err
+}
+
+func (c *resilientClient) GetNetworkID(ctx context.Context, options ...rpc.Option) (networkID uint32, err error) {
+	err = c.call(ctx, "GetNetworkID", true, func(ctx context.Context) (err error) {
+		networkID, err = c.Client.GetNetworkID(ctx, options...)
+		return err
+	})
+	return networkID, err
+}
+
+func (c *resilientClient) GetBlockchainID(ctx context.Context, alias string, options ...rpc.Option) (blockchainID ids.ID, err error) {
+	err = c.call(ctx, "GetBlockchainID", true, func(ctx context.Context) (err error) {
+		blockchainID, err = c.Client.GetBlockchainID(ctx, alias, options...)
+		return err
+	})
+	return blockchainID, err
+}
+
+func (c *resilientClient) IssueTx(ctx context.Context, txBytes []byte) (txID ids.ID, err error) {
+	err = c.call(ctx, "IssueTx", false, func(ctx context.Context) (err error) {
+		txID, err = c.Client.IssueTx(ctx, txBytes)
+		return err
+	})
+	return txID, err
+}
+
+func (c *resilientClient) GetAtomicUTXOs(
+	ctx context.Context,
+	addrs []string,
+	sourceChain string,
+	limit uint32,
+	startAddress, startUTXOID string,
+) (utxos [][]byte, index api.Index, err error) {
+	err = c.call(ctx, "GetAtomicUTXOs", true, func(ctx context.Context) (err error) {
+		utxos, index, err = c.Client.GetAtomicUTXOs(ctx, addrs, sourceChain, limit, startAddress, startUTXOID)
+		return err
+	})
+	return utxos, index, err
+}
+
+func (c *resilientClient) EstimateBaseFee(ctx context.Context) (baseFee *big.Int, err error) {
+	err = c.call(ctx, "EstimateBaseFee", true, func(ctx context.Context) (err error) {
+		baseFee, err = c.Client.EstimateBaseFee(ctx)
+		return err
+	})
+	return baseFee, err
+}
+
+type resilientPChainClient struct {
+	PChainClient
+	resilience
+}
+
+// NewResilientPChainClient returns a PChainClient applying [config] to all calls. [observer] is optional.
+func NewResilientPChainClient(c PChainClient, name string, config ResilienceConfig, observer ResilienceObserver) PChainClient {
+	return &resilientPChainClient{
+		PChainClient: c,
+		resilience:   newResilience(name, config, observer),
+	}
+}
+
+func (c *resilientPChainClient) GetContainerByIndex(ctx context.Context, index uint64, options ...rpc.Option) (container indexer.Container, err error) {
+	err = c.call(ctx, "GetContainerByIndex", true, func(ctx context.Context) (err error) {
+		container, err = c.PChainClient.GetContainerByIndex(ctx, index, options...)
+		return err
+	})
+	return container, err
+}
+
+func (c *resilientPChainClient) GetContainerByID(ctx context.Context, containerID ids.ID, options ...rpc.Option) (container indexer.Container, err error) {
+	err = c.call(ctx, "GetContainerByID", true, func(ctx context.Context) (err error) {
+		container, err = c.PChainClient.GetContainerByID(ctx, containerID, options...)
+		return err
+	})
+	return container, err
+}
+
+func (c *resilientPChainClient) GetLastAccepted(ctx context.Context, options ...rpc.Option) (container indexer.Container, err error) {
+	err = c.call(ctx, "GetLastAccepted", true, func(ctx context.Context) (err error) {
+		container, err = c.PChainClient.GetLastAccepted(ctx, options...)
+		return err
+	})
+	return container, err
+}
+
+func (c *resilientPChainClient) GetUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (utxos [][]byte, endAddress ids.ShortID, endUTXOID ids.ID, err error) {
+	err = c.call(ctx, "GetUTXOs", true, func(ctx context.Context) (err error) {
+		utxos, endAddress, endUTXOID, err = c.PChainClient.GetUTXOs(ctx, addrs, limit, startAddress, startUTXOID, options...)
+		return err
+	})
+	return utxos, endAddress, endUTXOID, err
+}
+
+func (c *resilientPChainClient) GetAtomicUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	sourceChain string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (utxos [][]byte, endAddress ids.ShortID, endUTXOID ids.ID, err error) {
+	err = c.call(ctx, "GetAtomicUTXOs", true, func(ctx context.Context) (err error) {
+		utxos, endAddress, endUTXOID, err = c.PChainClient.GetAtomicUTXOs(ctx, addrs, sourceChain, limit, startAddress, startUTXOID, options...)
+		return err
+	})
+	return utxos, endAddress, endUTXOID, err
+}
+
+func (c *resilientPChainClient) GetRewardUTXOs(ctx context.Context, args *api.GetTxArgs, options ...rpc.Option) (utxos [][]byte, err error) {
+	err = c.call(ctx, "GetRewardUTXOs", true, func(ctx context.Context) (err error) {
+		utxos, err = c.PChainClient.GetRewardUTXOs(ctx, args, options...)
+		return err
+	})
+	return utxos, err
+}
+
+func (c *resilientPChainClient) GetHeight(ctx context.Context, options ...rpc.Option) (height uint64, err error) {
+	err = c.call(ctx, "GetHeight", true, func(ctx context.Context) (err error) {
+		height, err = c.PChainClient.GetHeight(ctx, options...)
+		return err
+	})
+	return height, err
+}
+
+func (c *resilientPChainClient) GetBalance(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (balance *platformvm.GetBalanceResponse, err error) {
+	err = c.call(ctx, "GetBalance", true, func(ctx context.Context) (err error) {
+		balance, err = c.PChainClient.GetBalance(ctx, addrs, options...)
+		return err
+	})
+	return balance, err
+}
+
+func (c *resilientPChainClient) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) (txBytes []byte, err error) {
+	err = c.call(ctx, "GetTx", true, func(ctx context.Context) (err error) {
+		txBytes, err = c.PChainClient.GetTx(ctx, txID, options...)
+		return err
+	})
+	return txBytes, err
+}
+
+func (c *resilientPChainClient) GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) (blockBytes []byte, err error) {
+	err = c.call(ctx, "GetBlock", true, func(ctx context.Context) (err error) {
+		blockBytes, err = c.PChainClient.GetBlock(ctx, blockID, options...)
+		return err
+	})
+	return blockBytes, err
+}
+
+func (c *resilientPChainClient) IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (txID ids.ID, err error) {
+	err = c.call(ctx, "IssueTx", false, func(ctx context.Context) (err error) {
+		txID, err = c.PChainClient.IssueTx(ctx, tx, options...)
+		return err
+	})
+	return txID, err
+}
+
+func (c *resilientPChainClient) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (status *platformvm.GetTxStatusResponse, err error) {
+	err = c.call(ctx, "GetTxStatus", true, func(ctx context.Context) (err error) {
+		status, err = c.PChainClient.GetTxStatus(ctx, txID, options...)
+		return err
+	})
+	return status, err
+}
+
+func (c *resilientPChainClient) GetStake(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (staked map[ids.ID]uint64, stakedOuts [][]byte, err error) {
+	err = c.call(ctx, "GetStake", true, func(ctx context.Context) (err error) {
+		staked, stakedOuts, err = c.PChainClient.GetStake(ctx, addrs, options...)
+		return err
+	})
+	return staked, stakedOuts, err
+}
+
+func (c *resilientPChainClient) GetCurrentValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) (validators []platformvm.ClientPermissionlessValidator, err error) {
+	err = c.call(ctx, "GetCurrentValidators", true, func(ctx context.Context) (err error) {
+		validators, err = c.PChainClient.GetCurrentValidators(ctx, subnetID, nodeIDs, options...)
+		return err
+	})
+	return validators, err
+}
+
+func (c *resilientPChainClient) GetPendingValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) (validators []interface{}, delegators []interface{}, err error) {
+	err = c.call(ctx, "GetPendingValidators", true, func(ctx context.Context) (err error) {
+		validators, delegators, err = c.PChainClient.GetPendingValidators(ctx, subnetID, nodeIDs, options...)
+		return err
+	})
+	return validators, delegators, err
+}
+
+func (c *resilientPChainClient) GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (description *avm.GetAssetDescriptionReply, err error) {
+	err = c.call(ctx, "GetAssetDescription", true, func(ctx context.Context) (err error) {
+		description, err = c.PChainClient.GetAssetDescription(ctx, assetID, options...)
+		return err
+	})
+	return description, err
+}
+
+func (c *resilientPChainClient) IsBootstrapped(ctx context.Context, chain string, options ...rpc.Option) (bootstrapped bool, err error) {
+	err = c.call(ctx, "IsBootstrapped", true, func(ctx context.Context) (err error) {
+		bootstrapped, err = c.PChainClient.IsBootstrapped(ctx, chain, options...)
+		return err
+	})
+	return bootstrapped, err
+}
+
+func (c *resilientPChainClient) Peers(ctx context.Context, options ...rpc.Option) (peers []info.Peer, err error) {
+	err = c.call(ctx, "Peers", true, func(ctx context.Context) (err error) {
+		peers, err = c.PChainClient.Peers(ctx, options...)
+		return err
+	})
+	return peers, err
+}
+
+func (c *resilientPChainClient) GetNodeID(ctx context.Context, options ...rpc.Option) (nodeID ids.NodeID, pop *signer.ProofOfPossession, err error) {
+	err = c.call(ctx, "GetNodeID", true, func(ctx context.Context) (err error) {
+		nodeID, pop, err = c.PChainClient.GetNodeID(ctx, options...)
+		return err
+	})
+	return nodeID, pop, err
+}
+
+func (c *resilientPChainClient) GetNetworkID(ctx context.Context, options ...rpc.Option) (networkID uint32, err error) {
+	err = c.call(ctx, "GetNetworkID", true, func(ctx context.Context) (err error) {
+		networkID, err = c.PChainClient.GetNetworkID(ctx, options...)
+		return err
+	})
+	return networkID, err
+}
+
+func (c *resilientPChainClient) GetBlockchainID(ctx context.Context, alias string, options ...rpc.Option) (blockchainID ids.ID, err error) {
+	err = c.call(ctx, "GetBlockchainID", true, func(ctx context.Context) (err error) {
+		blockchainID, err = c.PChainClient.GetBlockchainID(ctx, alias, options...)
+		return err
+	})
+	return blockchainID, err
+}
+
+func (c *resilientPChainClient) GetTxFee(ctx context.Context, options ...rpc.Option) (fee *info.GetTxFeeResponse, err error) {
+	err = c.call(ctx, "GetTxFee", true, func(ctx context.Context) (err error) {
+		fee, err = c.PChainClient.GetTxFee(ctx, options...)
+		return err
+	})
+	return fee, err
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -50,6 +51,29 @@ type Call struct {
 	Revert  bool           `json:"revert"`
 	Error   string         `json:"error,omitempty"`
 	Calls   []*Call        `json:"calls,omitempty"`
+
+	// Input and Output are decoded from the trace but not serialized, so that
+	// the trace returned in the transaction metadata is unchanged
+	Input  hexutil.Bytes `json:"-"`
+	Output hexutil.Bytes `json:"-"`
+}
+
+// UnmarshalJSON decodes a call of the call tracer, including its input and output
+func (c *Call) UnmarshalJSON(data []byte) error {
+	type call Call
+	var dec struct {
+		*call
+		Input  hexutil.Bytes `json:"input"`
+		Output hexutil.Bytes `json:"output"`
+	}
+	dec.call = (*call)(c)
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+
+	c.Input = dec.Input
+	c.Output = dec.Output
+	return nil
 }
 
 type FlatCall struct {
@@ -58,23 +82,31 @@ type FlatCall struct {
 	To      common.Address `json:"to"`
 	Value   *big.Int       `json:"value"`
 	GasUsed *big.Int       `json:"gasUsed"`
+	Input   []byte         `json:"input"`
+	Output  []byte         `json:"output,omitempty"`
 	Revert  bool           `json:"revert"`
 	Error   string         `json:"error,omitempty"`
+
+	// Depth is the call depth of the frame, 0 for the top level call
+	Depth int `json:"depth"`
 }
 
-func (c *Call) flatten() *FlatCall {
+func (c *Call) flatten(depth int) *FlatCall {
 	return &FlatCall{
 		Type:    c.Type,
 		From:    c.From,
 		To:      c.To,
 		Value:   c.Value.ToInt(),
 		GasUsed: c.GasUsed.ToInt(),
+		Input:   c.Input,
+		Output:  c.Output,
 		Revert:  c.Revert,
 		Error:   c.Error,
+		Depth:   depth,
 	}
 }
 
-func (c *Call) init() []*FlatCall {
+func (c *Call) init(depth int) []*FlatCall {
 	if c.Value == nil {
 		c.Value = new(hexutil.Big)
 	}
@@ -87,7 +119,7 @@ func (c *Call) init() []*FlatCall {
 		c.Revert = true
 	}
 
-	results := []*FlatCall{c.flatten()}
+	results := []*FlatCall{c.flatten(depth)}
 	for _, child := range c.Calls {
 		// Ensure all children of a reverted call
 		// are also reverted!
@@ -101,7 +133,7 @@ func (c *Call) init() []*FlatCall {
 			}
 		}
 
-		children := child.init()
+		children := child.init(depth + 1)
 		results = append(results, children...)
 	}
 
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/avm"
+)
+
+// Interface compliance
+var _ XChainClient = &xchainClient{}
+
+type XChainClient interface {
+	// indexer.Client methods
+	GetContainerByIndex(ctx context.Context, index uint64, options ...rpc.Option) (indexer.Container, error)
+	GetLastAccepted(context.Context, ...rpc.Option) (indexer.Container, error)
+	GetIndex(ctx context.Context, containerID ids.ID, options ...rpc.Option) (uint64, error)
+
+	// avm.Client methods
+
+	GetUTXOs(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) ([][]byte, ids.ShortID, ids.ID, error)
+	GetAtomicUTXOs(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		sourceChain string,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) ([][]byte, ids.ShortID, ids.ID, error)
+	GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*avm.GetAssetDescriptionReply, error)
+	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
+	IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error)
+
+	// info.Client methods
+	IsBootstrapped(context.Context, string, ...rpc.Option) (bool, error)
+	Peers(context.Context, ...rpc.Option) ([]info.Peer, error)
+	GetNetworkID(context.Context, ...rpc.Option) (uint32, error)
+	GetBlockchainID(context.Context, string, ...rpc.Option) (ids.ID, error)
+	GetTxFee(context.Context, ...rpc.Option) (*info.GetTxFeeResponse, error)
+}
+
+type avmClient = avm.Client
+
+type xchainClient struct {
+	avmClient
+	indexerClient
+	infoClient
+}
+
+// NewXChainClient returns a new client for Avalanche APIs related to X-chain
+func NewXChainClient(ctx context.Context, endpoint, indexerEndpoint string) XChainClient {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	return xchainClient{
+		avmClient:     avm.NewClient(endpoint, "X"),
+		infoClient:    info.NewClient(endpoint),
+		indexerClient: indexer.NewClient(indexerEndpoint + "/ext/index/X/tx"),
+	}
+}
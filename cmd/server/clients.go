@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/client/contractregistry"
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	"github.com/ava-labs/avalanche-rosetta/metrics"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+// endpoints returns the endpoints to create clients for. Calls are only
+// routed between several nodes in online mode.
+func (c *config) endpoints() []endpointConfig {
+	if c.Mode == service.ModeOffline {
+		return c.Endpoints[:1]
+	}
+	return c.Endpoints
+}
+
+// nodeName returns the name under which calls to the [i]th node of [chain]
+// are reported
+func nodeName(chain string, i int, count int) string {
+	if count == 1 {
+		return chain
+	}
+	return fmt.Sprintf("%s-%d", chain, i)
+}
+
+// resilienceObserver returns [serverMetrics] as an observer, if enabled
+func resilienceObserver(serverMetrics *metrics.Metrics) client.ResilienceObserver {
+	if serverMetrics == nil {
+		return nil
+	}
+	return serverMetrics
+}
+
+// newCChainClient returns the C-chain client of the configured nodes,
+// reporting calls to [serverMetrics] when not nil
+func newCChainClient(
+	ctx context.Context,
+	cfg *config,
+	registry *contractregistry.Registry,
+	serverMetrics *metrics.Metrics,
+) (client.Client, error) {
+	endpoints := cfg.endpoints()
+	clients := make([]client.Client, 0, len(endpoints))
+	contractCaches := client.ContractCaches{}
+	for i, endpoint := range endpoints {
+		apiClient, err := client.NewClient(ctx, endpoint.RPCEndpoint, registry)
+		if err != nil {
+			return nil, err
+		}
+		if contractCache, ok := apiClient.(client.ContractCache); ok {
+			contractCaches = append(contractCaches, contractCache)
+		}
+
+		name := nodeName(mapper.CChainNetworkIdentifier, i, len(endpoints))
+		if serverMetrics != nil {
+			apiClient = client.NewObservedClient(apiClient, name, serverMetrics)
+		}
+		// Metrics are reported for every attempt of the calls made through the resilience layer
+		if cfg.Resilience != nil {
+			apiClient = client.NewResilientClient(apiClient, name, cfg.Resilience.clientConfig(), resilienceObserver(serverMetrics))
+		}
+		clients = append(clients, apiClient)
+	}
+
+	if serverMetrics != nil {
+		if err := serverMetrics.RegisterContractCache(contractCaches); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(clients) == 1 {
+		return clients[0], nil
+	}
+	return client.NewMultiClient(ctx, mapper.CChainNetworkIdentifier, clients), nil
+}
+
+// newPChainClient returns the P-chain client of the configured nodes,
+// reporting calls to [serverMetrics] when not nil
+func newPChainClient(ctx context.Context, cfg *config, serverMetrics *metrics.Metrics) client.PChainClient {
+	endpoints := cfg.endpoints()
+	clients := make([]client.PChainClient, 0, len(endpoints))
+	for i, endpoint := range endpoints {
+		pChainClient := client.NewPChainClient(ctx, endpoint.RPCEndpoint, endpoint.IndexerEndpoint)
+
+		name := nodeName(mapper.PChainNetworkIdentifier, i, len(endpoints))
+		if serverMetrics != nil {
+			pChainClient = client.NewObservedPChainClient(pChainClient, name, serverMetrics)
+		}
+		if cfg.Resilience != nil {
+			pChainClient = client.NewResilientPChainClient(pChainClient, name, cfg.Resilience.clientConfig(), resilienceObserver(serverMetrics))
+		}
+		clients = append(clients, pChainClient)
+	}
+
+	if len(clients) == 1 {
+		return clients[0]
+	}
+	return client.NewMultiPChainClient(ctx, mapper.PChainNetworkIdentifier, clients)
+}
@@ -4,10 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	"os"
+	"time"
 
+	"github.com/ava-labs/avalanchego/ids"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/mapper"
 	"github.com/ava-labs/avalanche-rosetta/service"
 )
 
@@ -19,6 +22,9 @@ var (
 	errInvalidErc20Address     = errors.New("not all token addresses provided are valid erc20s")
 	errInvalidIngestionMode    = errors.New("invalid rosetta ingestion mode")
 	errInvalidUnknownTokenMode = errors.New("cannot index unknown tokens while in standard ingestion mode")
+	errInvalidAssetID          = errors.New("invalid avax asset id provided")
+	errInvalidVerbosity        = errors.New("invalid metadata verbosity")
+	errMissingRegistryDir      = errors.New("contract registry seed requires a contract registry dir")
 )
 
 type config struct {
@@ -31,12 +37,109 @@ type config struct {
 	LogRequests      bool   `json:"log_requests"`
 	GenesisBlockHash string `json:"genesis_block_hash"`
 
+	// Endpoints lists the nodes to route calls between, defaulting to
+	// RPCEndpoint and IndexerEndpoint
+	Endpoints []endpointConfig `json:"endpoints"`
+
+	// Network parameters, only required for networks other than Mainnet and
+	// Fuji when they can't be fetched from the node
+	AvaxAssetID   string  `json:"avax_asset_id"`
+	AP5Activation *uint64 `json:"ap5_activation"`
+	HRP           string  `json:"hrp"`
+
+	// WrappedNativeTokens overrides the wrapped native token contracts, WAVAX
+	// on Mainnet and Fuji, whose deposits and withdrawals are reported
+	WrappedNativeTokens []string `json:"wrapped_native_tokens"`
+
+	// MetricsEnabled exposes Prometheus metrics at /metrics
+	MetricsEnabled bool `json:"metrics_enabled"`
+
+	// Resilience enables timeouts, retries and circuit breaking of calls to the node
+	Resilience *resilienceConfig `json:"resilience"`
+
+	// PChainUTXOIndexDir enables historical P-chain balance lookups, backed
+	// by a UTXO index stored in this directory
+	PChainUTXOIndexDir string `json:"pchain_utxo_index_dir"`
+
+	// ContractRegistryDir persists the looked up ERC-20 and ERC-721 contract
+	// information in this directory
+	ContractRegistryDir string `json:"contract_registry_dir"`
+
+	// ContractRegistrySeed is a JSON token list registered on startup
+	ContractRegistrySeed string `json:"contract_registry_seed"`
+
+	// UTXOFetchAttempts is the number of times account UTXOs are fetched when
+	// the chain advances during the fetch
+	UTXOFetchAttempts int `json:"utxo_fetch_attempts"`
+
+	// IncludeZeroValueCalls reports zero value internal calls of C-Chain
+	// transactions along with their call metadata
+	IncludeZeroValueCalls bool `json:"include_zero_value_calls"`
+
+	// MetadataVerbosity is the verbosity of the C-Chain transaction metadata
+	// in /block responses: none, summary or full
+	MetadataVerbosity string `json:"metadata_verbosity"`
+
+	// ReceiptFetchConcurrency bounds the concurrent receipt requests of a
+	// block when the node rejects batched requests
+	ReceiptFetchConcurrency int `json:"receipt_fetch_concurrency"`
+
+	// BlockCacheSize is the number of C-chain and P-chain blocks kept in memory
+	// by each chain, a negative size disables the cache
+	BlockCacheSize int `json:"block_cache_size"`
+
 	IngestionMode          string   `json:"ingestion_mode"`
 	TokenWhiteList         []string `json:"token_whitelist"`
 	IndexUnknownTokens     bool     `json:"index_unknown_tokens"`
 	ValidateERC20Whitelist bool     `json:"validate_erc20_whitelist"`
 }
 
+type endpointConfig struct {
+	RPCEndpoint     string `json:"rpc_endpoint"`
+	IndexerEndpoint string `json:"indexer_endpoint"`
+}
+
+// resilienceConfig configures client.ResilienceConfig with durations in
+// milliseconds, unset fields using client.DefaultResilienceConfig
+type resilienceConfig struct {
+	TimeoutMs        int64            `json:"timeout_ms"`
+	MethodTimeoutsMs map[string]int64 `json:"method_timeouts_ms"`
+	MaxRetries       *int             `json:"max_retries"`
+	InitialBackoffMs int64            `json:"initial_backoff_ms"`
+	MaxBackoffMs     int64            `json:"max_backoff_ms"`
+	FailureThreshold int              `json:"failure_threshold"`
+	OpenDurationMs   int64            `json:"open_duration_ms"`
+}
+
+func (c *resilienceConfig) clientConfig() client.ResilienceConfig {
+	cfg := client.DefaultResilienceConfig()
+	if c.TimeoutMs > 0 {
+		cfg.Timeout = time.Duration(c.TimeoutMs) * time.Millisecond
+	}
+	if len(c.MethodTimeoutsMs) > 0 {
+		cfg.MethodTimeouts = map[string]time.Duration{}
+		for method, timeoutMs := range c.MethodTimeoutsMs {
+			cfg.MethodTimeouts[method] = time.Duration(timeoutMs) * time.Millisecond
+		}
+	}
+	if c.MaxRetries != nil {
+		cfg.MaxRetries = *c.MaxRetries
+	}
+	if c.InitialBackoffMs > 0 {
+		cfg.InitialBackoff = time.Duration(c.InitialBackoffMs) * time.Millisecond
+	}
+	if c.MaxBackoffMs > 0 {
+		cfg.MaxBackoff = time.Duration(c.MaxBackoffMs) * time.Millisecond
+	}
+	if c.FailureThreshold > 0 {
+		cfg.FailureThreshold = c.FailureThreshold
+	}
+	if c.OpenDurationMs > 0 {
+		cfg.OpenDuration = time.Duration(c.OpenDurationMs) * time.Millisecond
+	}
+	return cfg
+}
+
 func readConfig(path string) (*config, error) {
 	cfg := &config{}
 
@@ -59,6 +162,19 @@ func (c *config) ApplyDefaults() {
 		c.IngestionMode = service.StandardIngestion
 	}
 
+	if c.BlockCacheSize == 0 {
+		c.BlockCacheSize = service.DefaultBlockCacheSize
+	}
+
+	if c.MetadataVerbosity == "" {
+		c.MetadataVerbosity = mapper.MetadataVerbosityFull
+	}
+
+	if c.RPCEndpoint == "" && len(c.Endpoints) > 0 {
+		c.RPCEndpoint = c.Endpoints[0].RPCEndpoint
+		c.IndexerEndpoint = c.Endpoints[0].IndexerEndpoint
+	}
+
 	if c.RPCEndpoint == "" {
 		c.RPCEndpoint = "http://localhost:9650"
 	}
@@ -67,6 +183,16 @@ func (c *config) ApplyDefaults() {
 		c.IndexerEndpoint = c.RPCEndpoint
 	}
 
+	if len(c.Endpoints) == 0 {
+		c.Endpoints = []endpointConfig{{RPCEndpoint: c.RPCEndpoint, IndexerEndpoint: c.IndexerEndpoint}}
+	}
+
+	for i := range c.Endpoints {
+		if c.Endpoints[i].IndexerEndpoint == "" {
+			c.Endpoints[i].IndexerEndpoint = c.Endpoints[i].RPCEndpoint
+		}
+	}
+
 	if c.ListenAddr == "" {
 		c.ListenAddr = "0.0.0.0:8080"
 	}
@@ -79,6 +205,12 @@ func (c *config) Validate() error {
 		return errMissingRPC
 	}
 
+	for _, endpoint := range c.Endpoints {
+		if endpoint.RPCEndpoint == "" {
+			return errMissingRPC
+		}
+	}
+
 	if !(c.Mode == service.ModeOffline || c.Mode == service.ModeOnline) {
 		return errInvalidMode
 	}
@@ -87,6 +219,12 @@ func (c *config) Validate() error {
 		return errGenesisBlockRequired
 	}
 
+	if c.AvaxAssetID != "" {
+		if _, err := ids.FromString(c.AvaxAssetID); err != nil {
+			return errInvalidAssetID
+		}
+	}
+
 	if len(c.TokenWhiteList) != 0 {
 		for _, token := range c.TokenWhiteList {
 			if !ethcommon.IsHexAddress(token) {
@@ -95,6 +233,12 @@ func (c *config) Validate() error {
 		}
 	}
 
+	for _, token := range c.WrappedNativeTokens {
+		if !ethcommon.IsHexAddress(token) {
+			return errInvalidTokenAddress
+		}
+	}
+
 	if !(c.IngestionMode == service.AnalyticsIngestion || c.IngestionMode == service.StandardIngestion) {
 		return errInvalidIngestionMode
 	}
@@ -102,6 +246,16 @@ func (c *config) Validate() error {
 	if c.IngestionMode == service.StandardIngestion && c.IndexUnknownTokens {
 		return errInvalidUnknownTokenMode
 	}
+
+	if c.ContractRegistrySeed != "" && c.ContractRegistryDir == "" {
+		return errMissingRegistryDir
+	}
+
+	switch c.MetadataVerbosity {
+	case mapper.MetadataVerbosityNone, mapper.MetadataVerbositySummary, mapper.MetadataVerbosityFull:
+	default:
+		return errInvalidVerbosity
+	}
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"log"
 	"math/big"
 	"net/http"
+	"strings"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/coinbase/rosetta-sdk-go/asserter"
@@ -15,12 +16,17 @@ import (
 	"github.com/coinbase/rosetta-sdk-go/types"
 
 	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/client/contractregistry"
 	"github.com/ava-labs/avalanche-rosetta/mapper"
 	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	"github.com/ava-labs/avalanche-rosetta/metrics"
 	"github.com/ava-labs/avalanche-rosetta/service"
 	"github.com/ava-labs/avalanche-rosetta/service/backend/cchainatomictx"
 	"github.com/ava-labs/avalanche-rosetta/service/backend/pchain"
 	"github.com/ava-labs/avalanche-rosetta/service/backend/pchain/indexer"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/pchain/utxoindex"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/xchain"
 )
 
 var (
@@ -36,10 +42,11 @@ var opts struct {
 func init() {
 	flag.StringVar(&opts.configPath, "config", "", "Path to configuration file")
 	flag.BoolVar(&opts.version, "version", false, "Print version")
-	flag.Parse()
 }
 
 func main() {
+	flag.Parse()
+
 	if opts.version {
 		log.Printf("%s %s\n", cmdName, cmdVersion)
 		return
@@ -57,7 +64,23 @@ func main() {
 		log.Fatal("config validation error:", err)
 	}
 
-	apiClient, err := client.NewClient(context.Background(), cfg.RPCEndpoint)
+	var serverMetrics *metrics.Metrics
+	if cfg.MetricsEnabled {
+		serverMetrics, err = metrics.New()
+		if err != nil {
+			log.Fatal("metrics init error:", err)
+		}
+	}
+
+	var registry *contractregistry.Registry
+	if cfg.ContractRegistryDir != "" {
+		registry, err = contractregistry.Open(cfg.ContractRegistryDir)
+		if err != nil {
+			log.Fatal("unable to open contract registry:", err)
+		}
+	}
+
+	apiClient, err := newCChainClient(context.Background(), cfg, registry, serverMetrics)
 	if err != nil {
 		log.Fatal("client init error:", err)
 	}
@@ -89,17 +112,14 @@ func main() {
 		cfg.ChainID = chainID.Int64()
 	}
 
-	var assetID string
-	var AP5Activation uint64
-	switch cfg.ChainID {
-	case mapper.MainnetChainID:
-		assetID = mapper.MainnetAssetID
-		AP5Activation = mapper.MainnetAP5Activation.Uint64()
-	case mapper.FujiChainID:
-		assetID = mapper.FujiAssetID
-		AP5Activation = mapper.FujiAP5Activation.Uint64()
-	default:
-		log.Fatal("invalid ChainID:", cfg.ChainID)
+	// Token lists span several chains, so the registry is seeded once the
+	// chain ID is known
+	if registry != nil && cfg.ContractRegistrySeed != "" {
+		count, err := registry.Seed(cfg.ContractRegistrySeed, cfg.ChainID)
+		if err != nil {
+			log.Fatal("unable to seed contract registry:", err)
+		}
+		log.Printf("seeded contract registry with %d tokens\n", count)
 	}
 
 	if cfg.NetworkName == "" {
@@ -116,6 +136,15 @@ func main() {
 		cfg.NetworkName = networkName
 	}
 
+	pChainClient := newPChainClient(context.Background(), cfg, serverMetrics)
+
+	networkParams, err := resolveNetworkParams(context.Background(), cfg, pChainClient)
+	if err != nil {
+		log.Fatal("cant resolve network parameters:", err)
+	}
+	mapper.RegisterHRP(cfg.NetworkName, networkParams.hrp)
+	assetID := networkParams.avaxAssetID
+
 	networkP := &types.NetworkIdentifier{
 		Blockchain: service.BlockchainName,
 		Network:    cfg.NetworkName,
@@ -123,25 +152,19 @@ func main() {
 			Network: mapper.PChainNetworkIdentifier,
 		},
 	}
+	networkX := &types.NetworkIdentifier{
+		Blockchain: service.BlockchainName,
+		Network:    cfg.NetworkName,
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{
+			Network: mapper.XChainNetworkIdentifier,
+		},
+	}
 	networkC := &types.NetworkIdentifier{
 		Blockchain: service.BlockchainName,
 		Network:    cfg.NetworkName,
 	}
 
-	var operationTypes []string
-	operationTypes = append(operationTypes, mapper.OperationTypes...)
-	operationTypes = append(operationTypes, pmapper.OperationTypes...)
-
-	asserter, err := asserter.NewServer(
-		operationTypes, // supported operation types
-		true,           // historical balance lookup
-		[]*types.NetworkIdentifier{ // supported networks
-			networkP,
-			networkC,
-		}, // supported networks
-		[]string{}, // call methods
-		false,      // mempool coins
-	)
+	asserter, err := newServerAsserter(networkP, networkX, networkC)
 	if err != nil {
 		log.Fatal("server asserter init error:", err)
 	}
@@ -152,10 +175,16 @@ func main() {
 		NetworkID:          networkC,
 		GenesisBlockHash:   cfg.GenesisBlockHash,
 		AvaxAssetID:        assetID,
-		AP5Activation:      AP5Activation,
+		AP5Activation:      networkParams.ap5Activation,
 		IndexUnknownTokens: cfg.IndexUnknownTokens,
 		IngestionMode:      cfg.IngestionMode,
 		TokenWhiteList:     cfg.TokenWhiteList,
+
+		WrappedNativeTokens:   networkParams.wrappedNativeTokens,
+		IncludeZeroValueCalls: cfg.IncludeZeroValueCalls,
+		MetadataVerbosity:     cfg.MetadataVerbosity,
+
+		ReceiptFetchConcurrency: cfg.ReceiptFetchConcurrency,
 	}
 
 	avaxAssetID, err := ids.FromString(assetID)
@@ -163,48 +192,141 @@ func main() {
 		log.Fatal("parse asset id failed:", err)
 	}
 
-	pChainClient := client.NewPChainClient(context.Background(), cfg.RPCEndpoint, cfg.IndexerEndpoint)
 	pIndexerParser, err := indexer.NewParser(pChainClient)
 	if err != nil {
 		log.Fatal("unable to initialize p-chain indexer parser:", err)
 	}
 	pChainBackend := pchain.NewBackend(pChainClient, pIndexerParser, avaxAssetID, networkP)
+	if cfg.PChainUTXOIndexDir != "" && cfg.Mode == service.ModeOnline {
+		utxoIndex, err := utxoindex.Open(cfg.PChainUTXOIndexDir)
+		if err != nil {
+			log.Fatal("unable to open p-chain utxo index:", err)
+		}
+
+		pChainBackend.EnableUTXOIndex(utxoIndex)
+		go pChainBackend.SyncUTXOIndex(context.Background())
+	}
+
+	xChainClient := client.NewXChainClient(context.Background(), cfg.RPCEndpoint, cfg.IndexerEndpoint)
+	if serverMetrics != nil {
+		xChainClient = client.NewObservedXChainClient(xChainClient, mapper.XChainNetworkIdentifier, serverMetrics)
+	}
+	xChainBackend, err := xchain.NewBackend(xChainClient, avaxAssetID, networkX)
+	if err != nil {
+		log.Fatal("unable to initialize x-chain backend:", err)
+	}
 
 	cChainAtomicTxBackend := cchainatomictx.NewBackend(apiClient, avaxAssetID)
 
-	handler := configureRouter(serviceConfig, asserter, apiClient, pChainBackend, cChainAtomicTxBackend)
+	cChainBlockCache := service.NewBlockCache(cfg.BlockCacheSize)
+	pChainBlockCache := service.NewBlockCache(cfg.BlockCacheSize)
+	pChainBackend.SetBlockCache(pChainBlockCache)
+	if serverMetrics != nil {
+		if err := serverMetrics.RegisterBlockCache(mapper.CChainNetworkIdentifier, cChainBlockCache); err != nil {
+			log.Fatal("unable to register c-chain block cache metrics:", err)
+		}
+		if err := serverMetrics.RegisterBlockCache(mapper.PChainNetworkIdentifier, pChainBlockCache); err != nil {
+			log.Fatal("unable to register p-chain block cache metrics:", err)
+		}
+	}
+
+	if cfg.UTXOFetchAttempts > 0 {
+		pChainBackend.SetUTXOFetchAttempts(cfg.UTXOFetchAttempts)
+		xChainBackend.SetUTXOFetchAttempts(cfg.UTXOFetchAttempts)
+		cChainAtomicTxBackend.SetUTXOFetchAttempts(cfg.UTXOFetchAttempts)
+	}
+
+	handler := configureRouter(serviceConfig, asserter, apiClient, pChainBackend, xChainBackend, cChainAtomicTxBackend, cChainBlockCache)
 	if cfg.LogRequests {
 		handler = inspectMiddleware(handler)
 	}
 	handler = server.LoggerMiddleware(handler)
 
+	if serverMetrics != nil {
+		handler = serverMetrics.Middleware(handler)
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", serverMetrics.Handler())
+		mux.Handle("/", handler)
+		handler = mux
+
+		if cfg.Mode == service.ModeOnline {
+			go serverMetrics.TrackHeight(context.Background(), mapper.CChainNetworkIdentifier, func(ctx context.Context) (uint64, error) {
+				header, err := apiClient.HeaderByNumber(ctx, nil)
+				if err != nil {
+					return 0, err
+				}
+				return header.Number.Uint64(), nil
+			})
+			go serverMetrics.TrackHeight(context.Background(), mapper.PChainNetworkIdentifier, func(ctx context.Context) (uint64, error) {
+				return pChainClient.GetHeight(ctx)
+			})
+		}
+	}
+
 	router := server.CorsMiddleware(handler)
 
+	rpcEndpoints := []string{}
+	for _, endpoint := range cfg.endpoints() {
+		rpcEndpoints = append(rpcEndpoints, endpoint.RPCEndpoint)
+	}
 	log.Printf(
 		`using avax (chain=%q chainid="%d" network=%q) rpc endpoint: %v`,
 		service.BlockchainName,
 		cfg.ChainID,
 		cfg.NetworkName,
-		cfg.RPCEndpoint,
+		strings.Join(rpcEndpoints, ", "),
 	)
 	log.Printf("starting rosetta server at %s\n", cfg.ListenAddr)
 
 	log.Fatal(http.ListenAndServe(cfg.ListenAddr, router))
 }
 
+// newServerAsserter returns the asserter of the requests to [networks],
+// accepting the operation types and call methods of all the chains
+func newServerAsserter(networks ...*types.NetworkIdentifier) (*asserter.Asserter, error) {
+	// P-chain and X-chain share import/export operation types
+	var operationTypes []string
+	seenOperationTypes := map[string]struct{}{}
+	for _, opTypes := range [][]string{mapper.OperationTypes, pmapper.OperationTypes, xmapper.OperationTypes} {
+		for _, opType := range opTypes {
+			if _, ok := seenOperationTypes[opType]; ok {
+				continue
+			}
+			seenOperationTypes[opType] = struct{}{}
+			operationTypes = append(operationTypes, opType)
+		}
+	}
+
+	var callMethods []string
+	callMethods = append(callMethods, mapper.CallMethods...)
+	callMethods = append(callMethods, pmapper.CallMethods...)
+	callMethods = append(callMethods, xmapper.CallMethods...)
+
+	return asserter.NewServer(
+		operationTypes, // supported operation types
+		true,           // historical balance lookup
+		networks,       // supported networks
+		callMethods,    // call methods
+		false,          // mempool coins
+	)
+}
+
 func configureRouter(
 	serviceConfig *service.Config,
 	asserter *asserter.Asserter,
 	apiClient client.Client,
 	pChainBackend *pchain.Backend,
+	xChainBackend *xchain.Backend,
 	cChainAtomicTxBackend *cchainatomictx.Backend,
+	cChainBlockCache *service.BlockCache,
 ) http.Handler {
-	networkService := service.NewNetworkService(serviceConfig, apiClient, pChainBackend)
-	blockService := service.NewBlockService(serviceConfig, apiClient, pChainBackend)
-	accountService := service.NewAccountService(serviceConfig, apiClient, pChainBackend, cChainAtomicTxBackend)
-	mempoolService := service.NewMempoolService(serviceConfig, apiClient)
-	constructionService := service.NewConstructionService(serviceConfig, apiClient, pChainBackend, cChainAtomicTxBackend)
-	callService := service.NewCallService(serviceConfig, apiClient)
+	networkService := service.NewNetworkService(serviceConfig, apiClient, pChainBackend, xChainBackend)
+	blockService := service.NewBlockService(serviceConfig, apiClient, pChainBackend, xChainBackend, cChainBlockCache)
+	accountService := service.NewAccountService(serviceConfig, apiClient, pChainBackend, xChainBackend, cChainAtomicTxBackend)
+	mempoolService := service.NewMempoolService(serviceConfig, apiClient, pChainBackend)
+	constructionService := service.NewConstructionService(serviceConfig, apiClient, pChainBackend, xChainBackend, cChainAtomicTxBackend)
+	callService := service.NewCallService(serviceConfig, apiClient, pChainBackend)
 
 	return server.NewRouter(
 		server.NewNetworkAPIController(networkService, asserter),
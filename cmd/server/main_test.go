@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/coinbase/rosetta-sdk-go/server"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+	serviceMocks "github.com/ava-labs/avalanche-rosetta/mocks/service"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+var (
+	testNetworkC = &types.NetworkIdentifier{
+		Blockchain: service.BlockchainName,
+		Network:    mapper.FujiNetwork,
+	}
+	testNetworkP = &types.NetworkIdentifier{
+		Blockchain:           service.BlockchainName,
+		Network:              mapper.FujiNetwork,
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{Network: mapper.PChainNetworkIdentifier},
+	}
+	testNetworkX = &types.NetworkIdentifier{
+		Blockchain:           service.BlockchainName,
+		Network:              mapper.FujiNetwork,
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{Network: mapper.XChainNetworkIdentifier},
+	}
+)
+
+// newCallRouter returns a router serving /call with the server asserter
+func newCallRouter(t *testing.T, apiClient *mocks.Client, pChainBackend *serviceMocks.CallBackend) http.Handler {
+	asserter, err := newServerAsserter(testNetworkP, testNetworkX, testNetworkC)
+	assert.NoError(t, err)
+
+	callService := service.NewCallService(&service.Config{Mode: service.ModeOnline}, apiClient, pChainBackend)
+	return server.NewRouter(server.NewCallAPIController(callService, asserter))
+}
+
+// postCall sends [req] to the /call endpoint of [router]
+func postCall(t *testing.T, router http.Handler, req *types.CallRequest) *httptest.ResponseRecorder {
+	body, err := json.Marshal(req)
+	assert.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/call", bytes.NewReader(body)))
+	return recorder
+}
+
+func TestCallMethods(t *testing.T) {
+	t.Run("p-chain staking methods are served", func(t *testing.T) {
+		for _, method := range pmapper.CallMethods {
+			t.Run(method, func(t *testing.T) {
+				pChainBackend := &serviceMocks.CallBackend{}
+				router := newCallRouter(t, &mocks.Client{}, pChainBackend)
+
+				req := &types.CallRequest{
+					NetworkIdentifier: testNetworkP,
+					Method:            method,
+					Parameters:        map[string]interface{}{},
+				}
+				pChainBackend.On("ShouldHandleRequest", mock.Anything).Return(true)
+				pChainBackend.
+					On("Call", mock.Anything, req).
+					Return(&types.CallResponse{Result: map[string]interface{}{"method": method}, Idempotent: true}, nil).
+					Once()
+
+				resp := postCall(t, router, req)
+				assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+				var callResp types.CallResponse
+				assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &callResp))
+				assert.Equal(t, method, callResp.Result["method"])
+				pChainBackend.AssertExpectations(t)
+			})
+		}
+	})
+
+	t.Run("c-chain transaction metadata is served", func(t *testing.T) {
+		apiClient := &mocks.Client{}
+		pChainBackend := &serviceMocks.CallBackend{}
+		router := newCallRouter(t, apiClient, pChainBackend)
+
+		txHash := common.HexToHash("0x0d8d2aed5a61a80e1325fc8c5e6f1e3a1d0bd4b6b1e1c1f3dba9d3c53ce9b3b1")
+		pChainBackend.On("ShouldHandleRequest", mock.Anything).Return(false)
+		apiClient.On("TransactionReceipt", mock.Anything, txHash).
+			Return(&ethtypes.Receipt{TxHash: txHash, Status: ethtypes.ReceiptStatusSuccessful, Logs: []*ethtypes.Log{}}, nil).
+			Once()
+		apiClient.On("TraceTransaction", mock.Anything, txHash.Hex()).
+			Return(&client.Call{Type: mapper.OpCall}, []*client.FlatCall{}, nil).
+			Once()
+
+		resp := postCall(t, router, &types.CallRequest{
+			NetworkIdentifier: testNetworkC,
+			Method:            mapper.CallGetTransactionMetadata,
+			Parameters:        map[string]interface{}{"tx_hash": txHash.Hex()},
+		})
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var callResp types.CallResponse
+		assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &callResp))
+		assert.Equal(t, txHash.Hex(), callResp.Result["receipt"].(map[string]interface{})["transactionHash"])
+		assert.Equal(t, mapper.OpCall, callResp.Result["trace"].(map[string]interface{})["type"])
+		apiClient.AssertExpectations(t)
+	})
+
+	t.Run("unknown methods are rejected", func(t *testing.T) {
+		router := newCallRouter(t, &mocks.Client{}, &serviceMocks.CallBackend{})
+
+		resp := postCall(t, router, &types.CallRequest{
+			NetworkIdentifier: testNetworkP,
+			Method:            "platform_getBalance",
+			Parameters:        map[string]interface{}{},
+		})
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/coreth/params"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+var (
+	errMissingAssetID = errors.New("avax asset id is not provided and can't be fetched in offline mode")
+	errMissingHRP     = errors.New("hrp is not provided and can't be derived from the network name in offline mode")
+)
+
+// networkParams holds the network specific values which are not exposed by
+// the C-chain rpc
+type networkParams struct {
+	avaxAssetID         string
+	ap5Activation       uint64
+	hrp                 string
+	wrappedNativeTokens []string
+}
+
+// resolveNetworkParams returns the parameters of the configured network.
+// Mainnet and Fuji values are built in, explicit config values take
+// precedence and anything left is fetched from the node when online.
+func resolveNetworkParams(ctx context.Context, cfg *config, pChainClient client.PChainClient) (*networkParams, error) {
+	p := &networkParams{}
+	ap5Activation := params.AvalancheLocalChainConfig.ApricotPhase5BlockTimestamp
+
+	switch cfg.ChainID {
+	case mapper.MainnetChainID:
+		p.avaxAssetID = mapper.MainnetAssetID
+		p.hrp = constants.GetHRP(constants.MainnetID)
+		ap5Activation = mapper.MainnetAP5Activation
+		p.wrappedNativeTokens = []string{mapper.MainnetWAVAXAddress}
+	case mapper.FujiChainID:
+		p.avaxAssetID = mapper.FujiAssetID
+		p.hrp = constants.GetHRP(constants.FujiID)
+		ap5Activation = mapper.FujiAP5Activation
+		p.wrappedNativeTokens = []string{mapper.FujiWAVAXAddress}
+	default:
+		log.Println("custom chain id", cfg.ChainID, "provided, resolving network parameters...")
+	}
+	p.ap5Activation = ap5Activation.Uint64()
+
+	if cfg.AvaxAssetID != "" {
+		p.avaxAssetID = cfg.AvaxAssetID
+	}
+	if cfg.AP5Activation != nil {
+		p.ap5Activation = *cfg.AP5Activation
+	}
+	if cfg.HRP != "" {
+		p.hrp = cfg.HRP
+	}
+	if cfg.WrappedNativeTokens != nil {
+		p.wrappedNativeTokens = cfg.WrappedNativeTokens
+	}
+
+	if p.avaxAssetID == "" {
+		if cfg.Mode == service.ModeOffline {
+			return nil, errMissingAssetID
+		}
+
+		log.Println("avax asset id is not provided, fetching from rpc...")
+		asset, err := pChainClient.GetAssetDescription(ctx, "AVAX")
+		if err != nil {
+			return nil, err
+		}
+		p.avaxAssetID = asset.AssetID.String()
+	}
+
+	if p.hrp == "" {
+		hrp, err := resolveHRP(ctx, cfg, pChainClient)
+		if err != nil {
+			return nil, err
+		}
+		p.hrp = hrp
+	}
+
+	return p, nil
+}
+
+func resolveHRP(ctx context.Context, cfg *config, pChainClient client.PChainClient) (string, error) {
+	if cfg.Mode == service.ModeOffline {
+		hrp, err := mapper.GetHRP(&types.NetworkIdentifier{Network: cfg.NetworkName})
+		if err != nil {
+			return "", errMissingHRP
+		}
+		return hrp, nil
+	}
+
+	log.Println("hrp is not provided, fetching network id from rpc...")
+	networkID, err := pChainClient.GetNetworkID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return constants.GetHRP(networkID), nil
+}
@@ -3,6 +3,7 @@ package cchainatomictx
 import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/coinbase/rosetta-sdk-go/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/ava-labs/avalanche-rosetta/mapper"
 )
@@ -14,6 +15,17 @@ func IsCChainBech32Address(accountIdentifier *types.AccountIdentifier) bool {
 	return false
 }
 
+// IsCChainHexAtomicAccount returns true for 0x addresses whose account metadata
+// identifies their atomic UTXOs through a public key or a bech32 address
+func IsCChainHexAtomicAccount(accountIdentifier *types.AccountIdentifier) bool {
+	if !ethcommon.IsHexAddress(accountIdentifier.Address) {
+		return false
+	}
+	_, hasPublicKey := accountIdentifier.Metadata[MetadataPublicKey]
+	_, hasBech32Address := accountIdentifier.Metadata[MetadataBech32Address]
+	return hasPublicKey || hasBech32Address
+}
+
 func IsAtomicOpType(t string) bool {
 	return t == mapper.OpExport || t == mapper.OpImport
 }
@@ -4,12 +4,16 @@ import (
 	"math/big"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/coinbase/rosetta-sdk-go/types"
 )
 
 const (
 	MetadataAtomicTxGas = "atomic_tx_gas"
 	MetadataNonce       = "nonce"
 	MetadataSourceChain = "source_chain"
+
+	MetadataPublicKey     = "public_key"
+	MetadataBech32Address = "bech32_address"
 )
 
 type Metadata struct {
@@ -28,3 +32,10 @@ type Options struct {
 	DestinationChain string   `json:"destination_chain,omitempty"`
 	Nonce            *big.Int `json:"nonce,omitempty"`
 }
+
+// AccountMetadata is the metadata of a 0x account identifying its atomic UTXOs,
+// either by its public key or by its pre-derived bech32 address
+type AccountMetadata struct {
+	PublicKey     *types.PublicKey `json:"public_key,omitempty"`
+	Bech32Address string           `json:"bech32_address,omitempty"`
+}
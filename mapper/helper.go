@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -16,6 +17,11 @@ import (
 
 var errUnrecognizedNetwork = errors.New("can't recognize network")
 
+var (
+	customHRPsLock sync.RWMutex
+	customHRPs     = map[string]string{}
+)
+
 // EqualFoldContains checks if the array contains the string regardless of casing
 func EqualFoldContains(arr []string, str string) bool {
 	for _, a := range arr {
@@ -26,6 +32,15 @@ func EqualFoldContains(arr []string, str string) bool {
 	return false
 }
 
+// RegisterHRP sets the hrp used for address formatting on a network other
+// than Mainnet and Fuji, e.g. a local or private devnet.
+func RegisterHRP(network string, hrp string) {
+	customHRPsLock.Lock()
+	defer customHRPsLock.Unlock()
+
+	customHRPs[network] = hrp
+}
+
 // GetHRP fetches hrp for address formatting.
 func GetHRP(networkIdentifier *types.NetworkIdentifier) (string, error) {
 	var hrp string
@@ -35,7 +50,20 @@ func GetHRP(networkIdentifier *types.NetworkIdentifier) (string, error) {
 	case MainnetNetwork:
 		hrp = constants.GetHRP(constants.MainnetID)
 	default:
-		return "", errUnrecognizedNetwork
+		customHRPsLock.RLock()
+		customHRP, ok := customHRPs[networkIdentifier.Network]
+		customHRPsLock.RUnlock()
+		if ok {
+			return customHRP, nil
+		}
+
+		// Fall back to the names avalanchego reports for its networks,
+		// such as "local" or "network-1337"
+		networkID, err := constants.NetworkID(networkIdentifier.Network)
+		if err != nil {
+			return "", errUnrecognizedNetwork
+		}
+		hrp = constants.GetHRP(networkID)
 	}
 
 	return hrp, nil
@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetHRP(t *testing.T) {
+	RegisterHRP("devnet", "custom")
+
+	testData := []struct {
+		network string
+		hrp     string
+	}{
+		{MainnetNetwork, "avax"},
+		{FujiNetwork, "fuji"},
+		{"devnet", "custom"},
+		{"local", "local"},
+		{"network-1337", "custom"},
+	}
+
+	for _, tc := range testData {
+		t.Run(tc.network, func(t *testing.T) {
+			hrp, err := GetHRP(&types.NetworkIdentifier{Network: tc.network})
+			assert.Nil(t, err)
+			assert.Equal(t, tc.hrp, hrp)
+		})
+	}
+
+	t.Run("unrecognized network", func(t *testing.T) {
+		_, err := GetHRP(&types.NetworkIdentifier{Network: "unknown"})
+		assert.Equal(t, errUnrecognizedNetwork, err)
+	})
+}
@@ -36,7 +36,7 @@ func buildImport() (*txs.ImportTx, map[string]*types.AccountIdentifier) {
 							},
 						},
 					},
-					{ //  this will be skipped as it is multisig
+					{ //  this will be skipped outside of construction as it is multisig
 						Asset: avax.Asset{ID: avaxAssetID},
 						FxID:  [32]byte{},
 						Out: &secp256k1fx.TransferOutput{
@@ -268,3 +268,42 @@ func buildValidatorTx() (*txs.AddValidatorTx, map[string]*types.AccountIdentifie
 
 	return addvalidator, inputTxAccounts
 }
+
+func buildBaseTx() (txs.BaseTx, map[string]*types.AccountIdentifier) {
+	avaxAssetID, _ := ids.FromString("U8iRqJoiJm8xZHAacmvYyZVwqQx6uDNtQeP3CQ6fcgQk3JqnK")
+	txID, _ := ids.FromString("2JQGX1MBdszAaeV6eApCZm7CBpc917qWiyQ2cygFRJ6WteDkre")
+	outAddr, _ := address.ParseToID("P-fuji1gdkq8g208e3j4epyjmx65jglsw7vauh86l47ac")
+	baseTx := txs.BaseTx{
+		BaseTx: avax.BaseTx{
+			NetworkID:    uint32(5),
+			BlockchainID: [32]byte{},
+			Outs: []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: avaxAssetID},
+				FxID:  [32]byte{},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 900000000,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Locktime:  0,
+						Threshold: 1,
+						Addrs:     []ids.ShortID{outAddr},
+					},
+				},
+			}},
+			Ins: []*avax.TransferableInput{{
+				UTXOID: avax.UTXOID{TxID: txID, OutputIndex: 0, Symbol: false},
+				Asset:  avax.Asset{ID: avaxAssetID},
+				FxID:   [32]byte{},
+				In: &secp256k1fx.TransferInput{
+					Amt:   1000000000,
+					Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+				},
+			}},
+			Memo: []byte{},
+		},
+	}
+
+	inputTxAccounts := map[string]*types.AccountIdentifier{}
+	inputTxAccounts[baseTx.Ins[0].String()] = &types.AccountIdentifier{Address: "P-fuji1gdkq8g208e3j4epyjmx65jglsw7vauh86l47ac"}
+
+	return baseTx, inputTxAccounts
+}
@@ -6,8 +6,13 @@ import (
 
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validator"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -17,7 +22,15 @@ import (
 	"github.com/ava-labs/avalanche-rosetta/mapper"
 )
 
-var errInvalidMetadata = errors.New("invalid metadata")
+var (
+	errInvalidMetadata          = errors.New("invalid metadata")
+	errMissingProofOfPossession = errors.New("primary network validators require a BLS public key and proof of possession")
+	errSubnetProofOfPossession  = errors.New("subnet validators do not have a BLS proof of possession")
+	errInvalidSubnetAuth        = errors.New("subnet auth signers do not match their signature indices")
+	errMissingOwners            = errors.New("multiple signature indices require the owners of the input")
+	errInsufficientSigIndices   = errors.New("fewer signature indices than the threshold of the input")
+	errInvalidSigIndices        = errors.New("signature indices must be sorted, unique and refer to owners")
+)
 
 func BuildTx(
 	opType string,
@@ -35,6 +48,18 @@ func BuildTx(
 		return buildAddValidatorTx(matches, payloadMetadata, codec, avaxAssetID)
 	case OpAddDelegator:
 		return buildAddDelegatorTx(matches, payloadMetadata, codec, avaxAssetID)
+	case OpAddPermissionlessValidator:
+		return buildAddPermissionlessValidatorTx(matches, payloadMetadata, codec, avaxAssetID)
+	case OpAddPermissionlessDelegator:
+		return buildAddPermissionlessDelegatorTx(matches, payloadMetadata, codec, avaxAssetID)
+	case OpCreateSubnet:
+		return buildCreateSubnetTx(matches, payloadMetadata, codec, avaxAssetID)
+	case OpCreateChain:
+		return buildCreateChainTx(matches, payloadMetadata, codec, avaxAssetID)
+	case OpAddSubnetValidator:
+		return buildAddSubnetValidatorTx(matches, payloadMetadata, codec, avaxAssetID)
+	case OpRemoveSubnetValidator:
+		return buildRemoveSubnetValidatorTx(matches, payloadMetadata, codec, avaxAssetID)
 	default:
 		return nil, nil, fmt.Errorf("invalid tx type: %s", opType)
 	}
@@ -229,6 +254,427 @@ func buildAddDelegatorTx(
 	return tx, signers, nil
 }
 
+func buildAddPermissionlessValidatorTx(
+	matches []*parser.Match,
+	sMetadata Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	if sMetadata.StakingMetadata == nil {
+		return nil, nil, errInvalidMetadata
+	}
+
+	blockchainID := sMetadata.BlockchainID
+
+	nodeID, err := ids.NodeIDFromString(sMetadata.NodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnetID, err := parseSubnetID(sMetadata.SubnetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer, err := buildSigner(subnetID, sMetadata.BLSPublicKey, sMetadata.BLSProofOfPossession)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validationRewardsOwner, err := buildOutputOwner(
+		sMetadata.RewardAddresses,
+		sMetadata.Locktime,
+		sMetadata.Threshold,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Delegation rewards go to the validation rewards owner unless specified
+	delegationRewardAddresses := sMetadata.DelegationRewardAddresses
+	if len(delegationRewardAddresses) == 0 {
+		delegationRewardAddresses = sMetadata.RewardAddresses
+	}
+	delegationRewardsOwner, err := buildOutputOwner(
+		delegationRewardAddresses,
+		sMetadata.Locktime,
+		sMetadata.Threshold,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ins, _, signers, err := buildInputs(matches[0].Operations, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse inputs failed: %w", err)
+	}
+
+	outs, stakeOutputs, _, err := buildOutputs(matches[1].Operations, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse outputs failed: %w", err)
+	}
+
+	memo, err := mapper.DecodeToBytes(sMetadata.Memo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse memo failed: %w", err)
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.AddPermissionlessValidatorTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    sMetadata.NetworkID,
+			BlockchainID: blockchainID,
+			Outs:         outs,
+			Ins:          ins,
+			Memo:         memo,
+		}},
+		Validator: validator.Validator{
+			NodeID: nodeID,
+			Start:  sMetadata.Start,
+			End:    sMetadata.End,
+			Wght:   sumOutputAmounts(stakeOutputs),
+		},
+		Subnet:                subnetID,
+		Signer:                signer,
+		StakeOuts:             stakeOutputs,
+		ValidatorRewardsOwner: validationRewardsOwner,
+		DelegatorRewardsOwner: delegationRewardsOwner,
+		DelegationShares:      sMetadata.Shares,
+	}}
+
+	return tx, signers, nil
+}
+
+func buildAddPermissionlessDelegatorTx(
+	matches []*parser.Match,
+	sMetadata Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	if sMetadata.StakingMetadata == nil {
+		return nil, nil, errInvalidMetadata
+	}
+
+	blockchainID := sMetadata.BlockchainID
+
+	nodeID, err := ids.NodeIDFromString(sMetadata.NodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnetID, err := parseSubnetID(sMetadata.SubnetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewardsOwner, err := buildOutputOwner(sMetadata.RewardAddresses, sMetadata.Locktime, sMetadata.Threshold)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ins, _, signers, err := buildInputs(matches[0].Operations, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse inputs failed: %w", err)
+	}
+
+	outs, stakeOutputs, _, err := buildOutputs(matches[1].Operations, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse outputs failed: %w", err)
+	}
+
+	memo, err := mapper.DecodeToBytes(sMetadata.Memo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse memo failed: %w", err)
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.AddPermissionlessDelegatorTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    sMetadata.NetworkID,
+			BlockchainID: blockchainID,
+			Outs:         outs,
+			Ins:          ins,
+			Memo:         memo,
+		}},
+		Validator: validator.Validator{
+			NodeID: nodeID,
+			Start:  sMetadata.Start,
+			End:    sMetadata.End,
+			Wght:   sumOutputAmounts(stakeOutputs),
+		},
+		Subnet:                 subnetID,
+		StakeOuts:              stakeOutputs,
+		DelegationRewardsOwner: rewardsOwner,
+	}}
+
+	return tx, signers, nil
+}
+
+func buildCreateSubnetTx(
+	matches []*parser.Match,
+	metadata Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	sMetadata := metadata.SubnetMetadata
+	if sMetadata == nil {
+		return nil, nil, errInvalidMetadata
+	}
+
+	owner, err := buildOutputOwner(sMetadata.SubnetOwners, sMetadata.Locktime, sMetadata.Threshold)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseTx, signers, err := buildSubnetBaseTx(matches, metadata, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.CreateSubnetTx{
+		BaseTx: *baseTx,
+		Owner:  owner,
+	}}
+
+	return tx, signers, nil
+}
+
+func buildCreateChainTx(
+	matches []*parser.Match,
+	metadata Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	sMetadata := metadata.SubnetMetadata
+	if sMetadata == nil {
+		return nil, nil, errInvalidMetadata
+	}
+
+	subnetID, err := ids.FromString(sMetadata.SubnetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse subnet id failed: %w", err)
+	}
+
+	vmID, err := ids.FromString(sMetadata.VMID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse vm id failed: %w", err)
+	}
+
+	fxIDs := make([]ids.ID, len(sMetadata.FxIDs))
+	for i, fxID := range sMetadata.FxIDs {
+		fxIDs[i], err = ids.FromString(fxID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse fx id failed: %w", err)
+		}
+	}
+	ids.SortIDs(fxIDs)
+
+	genesis, err := mapper.DecodeToBytes(sMetadata.Genesis)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse genesis failed: %w", err)
+	}
+
+	subnetAuth, authSigners, err := buildSubnetAuth(sMetadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseTx, signers, err := buildSubnetBaseTx(matches, metadata, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.CreateChainTx{
+		BaseTx:      *baseTx,
+		SubnetID:    subnetID,
+		ChainName:   sMetadata.ChainName,
+		VMID:        vmID,
+		FxIDs:       fxIDs,
+		GenesisData: genesis,
+		SubnetAuth:  subnetAuth,
+	}}
+
+	return tx, append(signers, authSigners...), nil
+}
+
+func buildAddSubnetValidatorTx(
+	matches []*parser.Match,
+	metadata Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	sMetadata := metadata.SubnetMetadata
+	if sMetadata == nil {
+		return nil, nil, errInvalidMetadata
+	}
+
+	subnetID, err := ids.FromString(sMetadata.SubnetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse subnet id failed: %w", err)
+	}
+
+	nodeID, err := ids.NodeIDFromString(sMetadata.NodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnetAuth, authSigners, err := buildSubnetAuth(sMetadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseTx, signers, err := buildSubnetBaseTx(matches, metadata, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.AddSubnetValidatorTx{
+		BaseTx: *baseTx,
+		Validator: validator.SubnetValidator{
+			Validator: validator.Validator{
+				NodeID: nodeID,
+				Start:  sMetadata.Start,
+				End:    sMetadata.End,
+				Wght:   sMetadata.Weight,
+			},
+			Subnet: subnetID,
+		},
+		SubnetAuth: subnetAuth,
+	}}
+
+	return tx, append(signers, authSigners...), nil
+}
+
+func buildRemoveSubnetValidatorTx(
+	matches []*parser.Match,
+	metadata Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	sMetadata := metadata.SubnetMetadata
+	if sMetadata == nil {
+		return nil, nil, errInvalidMetadata
+	}
+
+	subnetID, err := ids.FromString(sMetadata.SubnetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse subnet id failed: %w", err)
+	}
+
+	nodeID, err := ids.NodeIDFromString(sMetadata.NodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnetAuth, authSigners, err := buildSubnetAuth(sMetadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseTx, signers, err := buildSubnetBaseTx(matches, metadata, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.RemoveSubnetValidatorTx{
+		BaseTx:     *baseTx,
+		NodeID:     nodeID,
+		Subnet:     subnetID,
+		SubnetAuth: subnetAuth,
+	}}
+
+	return tx, append(signers, authSigners...), nil
+}
+
+// buildSubnetBaseTx builds the fee paying base tx of subnet transactions
+func buildSubnetBaseTx(
+	matches []*parser.Match,
+	metadata Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.BaseTx, []*types.AccountIdentifier, error) {
+	ins, _, signers, err := buildInputs(matches[0].Operations, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse inputs failed: %w", err)
+	}
+
+	outs, _, _, err := buildOutputs(matches[1].Operations, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse outputs failed: %w", err)
+	}
+
+	memo, err := mapper.DecodeToBytes(metadata.SubnetMetadata.Memo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse memo failed: %w", err)
+	}
+
+	return &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    metadata.NetworkID,
+		BlockchainID: metadata.BlockchainID,
+		Outs:         outs,
+		Ins:          ins,
+		Memo:         memo,
+	}}, signers, nil
+}
+
+// buildSubnetAuth returns the subnet auth input along with its signers, which
+// sign the transaction after the signers of its inputs
+func buildSubnetAuth(sMetadata *SubnetMetadata) (*secp256k1fx.Input, []*types.AccountIdentifier, error) {
+	if len(sMetadata.SubnetAuthSigIndices) != len(sMetadata.SubnetAuthSigners) {
+		return nil, nil, errInvalidSubnetAuth
+	}
+
+	signers := make([]*types.AccountIdentifier, len(sMetadata.SubnetAuthSigners))
+	for i, addr := range sMetadata.SubnetAuthSigners {
+		signers[i] = &types.AccountIdentifier{Address: addr}
+	}
+
+	return &secp256k1fx.Input{SigIndices: sMetadata.SubnetAuthSigIndices}, signers, nil
+}
+
+// parseSubnetID returns the primary network ID for an empty [subnetID]
+func parseSubnetID(subnetID string) (ids.ID, error) {
+	if subnetID == "" {
+		return constants.PrimaryNetworkID, nil
+	}
+	return ids.FromString(subnetID)
+}
+
+// buildSigner returns the BLS proof of possession of primary network
+// validators, and an empty signer for subnet validators
+func buildSigner(subnetID ids.ID, publicKey string, proofOfPossession string) (signer.Signer, error) {
+	if subnetID != constants.PrimaryNetworkID {
+		if publicKey != "" || proofOfPossession != "" {
+			return nil, errSubnetProofOfPossession
+		}
+		return &signer.Empty{}, nil
+	}
+
+	if publicKey == "" || proofOfPossession == "" {
+		return nil, errMissingProofOfPossession
+	}
+
+	// Keys are encoded as returned by info.getNodeID
+	publicKeyBytes, err := formatting.Decode(formatting.HexNC, publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("parse BLS public key failed: %w", err)
+	}
+	proofOfPossessionBytes, err := formatting.Decode(formatting.HexNC, proofOfPossession)
+	if err != nil {
+		return nil, fmt.Errorf("parse BLS proof of possession failed: %w", err)
+	}
+	if len(publicKeyBytes) != bls.PublicKeyLen || len(proofOfPossessionBytes) != bls.SignatureLen {
+		return nil, errInvalidMetadata
+	}
+
+	pop := &signer.ProofOfPossession{}
+	copy(pop.PublicKey[:], publicKeyBytes)
+	copy(pop.ProofOfPossession[:], proofOfPossessionBytes)
+	if err := pop.Verify(); err != nil {
+		return nil, err
+	}
+
+	return pop, nil
+}
+
 func buildOutputOwner(
 	addrs []string,
 	locktime uint64,
@@ -277,15 +723,24 @@ func buildInputs(
 			return nil, nil, nil, fmt.Errorf("parse operation amount failed: %w", err)
 		}
 
+		var transferIn avax.TransferableIn = &secp256k1fx.TransferInput{
+			Amt: val.Uint64(),
+			Input: secp256k1fx.Input{
+				SigIndices: opMetadata.SigIndices,
+			},
+		}
+		// Stakeable locked UTXOs are spent, to be staked, with the locktime of their output
+		if opMetadata.StakeableLocktime > 0 {
+			transferIn = &stakeable.LockIn{
+				Locktime:       opMetadata.StakeableLocktime,
+				TransferableIn: transferIn,
+			}
+		}
+
 		in := &avax.TransferableInput{
 			UTXOID: *UTXOID,
 			Asset:  avax.Asset{ID: avaxAssetID},
-			In: &secp256k1fx.TransferInput{
-				Amt: val.Uint64(),
-				Input: secp256k1fx.Input{
-					SigIndices: opMetadata.SigIndices,
-				},
-			},
+			In:     transferIn,
 		}
 
 		switch opMetadata.Type {
@@ -296,7 +751,11 @@ func buildInputs(
 		default:
 			return nil, nil, nil, fmt.Errorf("invalid option type: %s", op.Type)
 		}
-		signers = append(signers, op.Account)
+		inSigners, err := InputSigners(op, opMetadata)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		signers = append(signers, inSigners...)
 	}
 
 	avax.SortTransferableInputs(ins)
@@ -316,14 +775,46 @@ func ParseOpMetadata(metadata map[string]interface{}) (*OperationMetadata, error
 		operationMetadata.Threshold = 1
 	}
 
-	// set sig indices to a single signer if not provided
+	// set sig indices to the first threshold signers if not provided
 	if operationMetadata.SigIndices == nil {
-		operationMetadata.SigIndices = []uint32{0}
+		operationMetadata.SigIndices = make([]uint32, operationMetadata.Threshold)
+		for i := range operationMetadata.SigIndices {
+			operationMetadata.SigIndices[i] = uint32(i)
+		}
 	}
 
 	return &operationMetadata, nil
 }
 
+// InputSigners returns the accounts signing an input operation, one per signature index.
+// Inputs spending multisig UTXOs are signed by the owners at the signature indices,
+// other inputs are signed by the account of the operation.
+func InputSigners(op *types.Operation, opMetadata *OperationMetadata) ([]*types.AccountIdentifier, error) {
+	if len(opMetadata.Owners) == 0 {
+		if len(opMetadata.SigIndices) > 1 {
+			return nil, errMissingOwners
+		}
+		return []*types.AccountIdentifier{op.Account}, nil
+	}
+
+	if uint32(len(opMetadata.SigIndices)) < opMetadata.Threshold {
+		return nil, errInsufficientSigIndices
+	}
+
+	signers := make([]*types.AccountIdentifier, 0, len(opMetadata.SigIndices))
+	for i, sigIndex := range opMetadata.SigIndices {
+		if i > 0 && sigIndex <= opMetadata.SigIndices[i-1] {
+			return nil, errInvalidSigIndices
+		}
+		if int(sigIndex) >= len(opMetadata.Owners) {
+			return nil, errInvalidSigIndices
+		}
+		signers = append(signers, &types.AccountIdentifier{Address: opMetadata.Owners[sigIndex]})
+	}
+
+	return signers, nil
+}
+
 func buildOutputs(
 	operations []*types.Operation,
 	codec codec.Manager,
@@ -340,28 +831,46 @@ func buildOutputs(
 			return nil, nil, nil, fmt.Errorf("parse output operation Metadata failed: %w", err)
 		}
 
-		addrID, err := address.ParseToID(op.Account.Address)
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("parse output address failed: %w", err)
+		addrs := []string{op.Account.Address}
+		if len(opMetadata.Owners) > 0 {
+			addrs = opMetadata.Owners
 		}
 
 		outputOwners := &secp256k1fx.OutputOwners{
-			Addrs:     []ids.ShortID{addrID},
 			Locktime:  opMetadata.Locktime,
 			Threshold: opMetadata.Threshold,
 		}
+		for _, addr := range addrs {
+			addrID, err := address.ParseToID(addr)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("parse output address failed: %w", err)
+			}
+			outputOwners.Addrs = append(outputOwners.Addrs, addrID)
+		}
+		outputOwners.Sort()
+		if err := outputOwners.Verify(); err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid output owners: %w", err)
+		}
 
 		val, err := types.AmountValue(op.Amount)
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("parse operation amount failed: %w", err)
 		}
 
+		var transferOut avax.TransferableOut = &secp256k1fx.TransferOutput{
+			Amt:          val.Uint64(),
+			OutputOwners: *outputOwners,
+		}
+		if opMetadata.StakeableLocktime > 0 {
+			transferOut = &stakeable.LockOut{
+				Locktime:        opMetadata.StakeableLocktime,
+				TransferableOut: transferOut,
+			}
+		}
+
 		out := &avax.TransferableOutput{
 			Asset: avax.Asset{ID: avaxAssetID},
-			Out: &secp256k1fx.TransferOutput{
-				Amt:          val.Uint64(),
-				OutputOwners: *outputOwners,
-			},
+			Out:   transferOut,
 		}
 
 		switch opMetadata.Type {
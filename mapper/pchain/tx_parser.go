@@ -8,6 +8,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	pChainValidator "github.com/ava-labs/avalanchego/vms/platformvm/validator"
@@ -121,6 +122,10 @@ func (t *TxParser) Parse(txID ids.ID, tx txs.UnsignedTx) (*types.Transaction, er
 			txMetadata[mapper.MetadataExportedOutputs] = ops.ExportOuts
 		}
 
+		for key, value := range ops.Metadata {
+			txMetadata[key] = value
+		}
+
 		operations = ops.IncludedOperations()
 	}
 
@@ -190,7 +195,7 @@ func (t *TxParser) parseAddValidatorTx(txID ids.ID, tx *txs.AddValidatorTx) (*tx
 }
 
 func (t *TxParser) parseAddPermissionlessValidatorTx(txID ids.ID, tx *txs.AddPermissionlessValidatorTx) (*txOps, error) {
-	ops, err := t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpAddValidator)
+	ops, err := t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpAddPermissionlessValidator)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +225,7 @@ func (t *TxParser) parseAddDelegatorTx(txID ids.ID, tx *txs.AddDelegatorTx) (*tx
 }
 
 func (t *TxParser) parseAddPermissionlessDelegatorTx(txID ids.ID, tx *txs.AddPermissionlessDelegatorTx) (*txOps, error) {
-	ops, err := t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpAddDelegator)
+	ops, err := t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpAddPermissionlessDelegator)
 	if err != nil {
 		return nil, err
 	}
@@ -256,6 +261,10 @@ func (t *TxParser) parseRewardValidatorTx(tx *txs.RewardValidatorTx) (*txOps, er
 		validator = &utx.Validator
 	case *txs.AddDelegatorTx:
 		validator = &utx.Validator
+	case *txs.AddPermissionlessValidatorTx:
+		validator = &utx.Validator
+	case *txs.AddPermissionlessDelegatorTx:
+		validator = &utx.Validator
 	}
 	addMetadataToStakeOuts(ops, validator)
 
@@ -273,23 +282,126 @@ func addMetadataToStakeOuts(ops *txOps, validator *pChainValidator.Validator) {
 }
 
 func (t *TxParser) parseCreateSubnetTx(txID ids.ID, tx *txs.CreateSubnetTx) (*txOps, error) {
-	return t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpCreateSubnet)
+	ops, err := t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpCreateSubnet)
+	if err != nil {
+		return nil, err
+	}
+
+	owners, threshold, err := t.formatOwner(tx.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]interface{}{
+		MetadataSubnetOwners:    owners,
+		MetadataSubnetThreshold: threshold,
+	}
+	// The subnet ID is the ID of the transaction, unknown until it is signed
+	if !t.isConstruction {
+		metadata[MetadataSubnetID] = txID.String()
+	}
+	ops.addMetadata(metadata)
+
+	return ops, nil
 }
 
 func (t *TxParser) parseAddSubnetValidatorTx(txID ids.ID, tx *txs.AddSubnetValidatorTx) (*txOps, error) {
-	return t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpAddSubnetValidator)
+	ops, err := t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpAddSubnetValidator)
+	if err != nil {
+		return nil, err
+	}
+
+	ops.addMetadata(map[string]interface{}{
+		MetadataSubnetID:         tx.Validator.Subnet.String(),
+		MetadataValidatorNodeID:  tx.Validator.NodeID.String(),
+		MetadataValidatorWeight:  tx.Validator.Wght,
+		MetadataStakingStartTime: tx.Validator.Start,
+		MetadataStakingEndTime:   tx.Validator.End,
+	})
+
+	return ops, nil
 }
 
 func (t *TxParser) parseRemoveSubnetValidatorTx(txID ids.ID, tx *txs.RemoveSubnetValidatorTx) (*txOps, error) {
-	return t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpRemoveSubnetValidator)
+	ops, err := t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpRemoveSubnetValidator)
+	if err != nil {
+		return nil, err
+	}
+
+	ops.addMetadata(map[string]interface{}{
+		MetadataSubnetID:        tx.Subnet.String(),
+		MetadataValidatorNodeID: tx.NodeID.String(),
+	})
+
+	return ops, nil
 }
 
 func (t *TxParser) parseTransformSubnetTx(txID ids.ID, tx *txs.TransformSubnetTx) (*txOps, error) {
-	return t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpTransformSubnetValidator)
+	ops, err := t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpTransformSubnetValidator)
+	if err != nil {
+		return nil, err
+	}
+
+	ops.addMetadata(map[string]interface{}{
+		MetadataSubnetID: tx.Subnet.String(),
+		MetadataAssetID:  tx.AssetID.String(),
+		MetadataRewardConfig: &SubnetRewardConfig{
+			InitialSupply:            tx.InitialSupply,
+			MaximumSupply:            tx.MaximumSupply,
+			MinConsumptionRate:       tx.MinConsumptionRate,
+			MaxConsumptionRate:       tx.MaxConsumptionRate,
+			MinValidatorStake:        tx.MinValidatorStake,
+			MaxValidatorStake:        tx.MaxValidatorStake,
+			MinStakeDuration:         tx.MinStakeDuration,
+			MaxStakeDuration:         tx.MaxStakeDuration,
+			MinDelegationFee:         tx.MinDelegationFee,
+			MinDelegatorStake:        tx.MinDelegatorStake,
+			MaxValidatorWeightFactor: tx.MaxValidatorWeightFactor,
+			UptimeRequirement:        tx.UptimeRequirement,
+		},
+	})
+
+	return ops, nil
 }
 
 func (t *TxParser) parseCreateChainTx(txID ids.ID, tx *txs.CreateChainTx) (*txOps, error) {
-	return t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpCreateChain)
+	ops, err := t.baseTxToCombinedOperations(txID, &tx.BaseTx, OpCreateChain)
+	if err != nil {
+		return nil, err
+	}
+
+	fxIDs := make([]string, len(tx.FxIDs))
+	for i, fxID := range tx.FxIDs {
+		fxIDs[i] = fxID.String()
+	}
+
+	ops.addMetadata(map[string]interface{}{
+		MetadataSubnetID:  tx.SubnetID.String(),
+		MetadataChainName: tx.ChainName,
+		MetadataVMID:      tx.VMID.String(),
+		MetadataFxIDs:     fxIDs,
+	})
+
+	return ops, nil
+}
+
+// formatOwner returns the addresses and threshold of a subnet owner
+func (t *TxParser) formatOwner(owner fx.Owner) ([]string, uint32, error) {
+	outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, 0, errOutputTypeAssertion
+	}
+
+	addrs := make([]string, len(outputOwners.Addrs))
+	for i, addr := range outputOwners.Addrs {
+		var err error
+		addrs[i], err = address.Format(mapper.PChainNetworkIdentifier, t.hrp, addr[:])
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return addrs, outputOwners.Threshold, nil
 }
 
 func (t *TxParser) baseTxToCombinedOperations(txID ids.ID, tx *txs.BaseTx, txType string) (*txOps, error) {
@@ -324,7 +436,13 @@ func (t *TxParser) insToOperations(
 			Type: metaType,
 		}
 
-		if transferInput, ok := in.In.(*secp256k1fx.TransferInput); ok {
+		transferableIn := in.In
+		if lockIn, ok := transferableIn.(*stakeable.LockIn); ok {
+			metadata.StakeableLocktime = lockIn.Locktime
+			transferableIn = lockIn.TransferableIn
+		}
+
+		if transferInput, ok := transferableIn.(*secp256k1fx.TransferInput); ok {
 			metadata.SigIndices = transferInput.SigIndices
 		}
 
@@ -400,8 +518,10 @@ func (t *TxParser) outsToOperations(
 	for outIndex, out := range txOut {
 		transferOut := out.Out
 
+		var stakeableLocktime uint64
 		if lockOut, ok := transferOut.(*stakeable.LockOut); ok {
 			transferOut = lockOut.TransferableOut
+			stakeableLocktime = lockOut.Locktime
 		}
 
 		transferOutput, ok := transferOut.(*secp256k1fx.TransferOutput)
@@ -409,15 +529,16 @@ func (t *TxParser) outsToOperations(
 			return errOutputTypeAssertion
 		}
 
-		// Rosetta cannot handle multisig at the moment. In order to pass data validation,
-		// we treat multisig outputs like a burn and inputs line a mint and therefore
-		// not include them in the operations
+		// Rosetta cannot attribute multisig outputs to a single account. In order to pass
+		// data validation, we treat multisig outputs like a burn and inputs line a mint and
+		// therefore not include them in the operations, except during construction where
+		// they are reported with their owners in metadata
 		//
 		// Additionally, it is possible to have outputs without any addresses
 		// (e.g. https://testnet.avascan.info/blockchain/p/block/81016)
 		//
-		// therefore we skip parsing operations unless there is exactly 1 address
-		if len(transferOutput.Addrs) != 1 {
+		// therefore we skip outputs without addresses, and multisig outputs outside of construction
+		if len(transferOutput.Addrs) == 0 || (len(transferOutput.Addrs) > 1 && !t.isConstruction) {
 			continue
 		}
 
@@ -427,6 +548,7 @@ func (t *TxParser) outsToOperations(
 			outOps.Len(),
 			txID,
 			uint32(outIndexOffset+outIndex),
+			stakeableLocktime,
 			opType,
 			metaType,
 			chainIDAlias,
@@ -455,8 +577,11 @@ func (t *TxParser) utxosToOperations(
 
 	for _, utxo := range utxos {
 		outIntf := utxo.Out
+
+		var stakeableLocktime uint64
 		if lockedOut, ok := outIntf.(*stakeable.LockOut); ok {
 			outIntf = lockedOut.TransferableOut
+			stakeableLocktime = lockedOut.Locktime
 		}
 
 		out, ok := outIntf.(*secp256k1fx.TransferOutput)
@@ -483,6 +608,7 @@ func (t *TxParser) utxosToOperations(
 			outOps.Len(),
 			utxo.TxID,
 			utxo.OutputIndex,
+			stakeableLocktime,
 			opType,
 			metaType,
 			chainIDAlias,
@@ -503,6 +629,7 @@ func (t *TxParser) buildOutputOperation(
 	startIndex int,
 	txID ids.ID,
 	outIndex uint32,
+	stakeableLocktime uint64,
 	opType, metaType, chainIDAlias string,
 ) (*types.Operation, error) {
 	if len(out.Addrs) == 0 {
@@ -516,9 +643,20 @@ func (t *TxParser) buildOutputOperation(
 	}
 
 	metadata := &OperationMetadata{
-		Type:      metaType,
-		Threshold: out.OutputOwners.Threshold,
-		Locktime:  out.OutputOwners.Locktime,
+		Type:              metaType,
+		Threshold:         out.OutputOwners.Threshold,
+		Locktime:          out.OutputOwners.Locktime,
+		StakeableLocktime: stakeableLocktime,
+	}
+
+	if len(out.Addrs) > 1 {
+		for _, addr := range out.Addrs {
+			owner, err := address.Format(chainIDAlias, t.hrp, addr[:])
+			if err != nil {
+				return nil, err
+			}
+			metadata.Owners = append(metadata.Owners, owner)
+		}
 	}
 
 	opMetadata, err := mapper.MarshalJSONMap(metadata)
@@ -718,6 +856,10 @@ type txOps struct {
 	StakeOuts      []*types.Operation
 	ImportIns      []*types.Operation
 	ExportOuts     []*types.Operation
+
+	// Metadata describes transactions without stake outputs, such as subnet
+	// transactions, and is added to the transaction and its first operation
+	Metadata map[string]interface{}
 }
 
 func newTxOps(isConstruction bool) *txOps {
@@ -732,6 +874,18 @@ func (t *txOps) IncludedOperations() []*types.Operation {
 	return ops
 }
 
+func (t *txOps) addMetadata(metadata map[string]interface{}) {
+	t.Metadata = metadata
+
+	ops := t.IncludedOperations()
+	if len(ops) == 0 {
+		return
+	}
+	for key, value := range metadata {
+		ops[0].Metadata[key] = value
+	}
+}
+
 // Used to populate operation identifier
 func (t *txOps) Len() int {
 	return len(t.Ins) + len(t.Outs) + len(t.StakeOuts)
@@ -6,7 +6,11 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/validator"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/stretchr/testify/assert"
 
@@ -147,18 +151,30 @@ func TestMapImportTx(t *testing.T) {
 	rosettaTransaction, err := parser.Parse(ids.Empty, importTx)
 	assert.Nil(t, err)
 
-	total := len(importTx.Ins) + len(importTx.Outs) + len(importTx.ImportedInputs) - 2 // - 1 for the multisig output
+	total := len(importTx.Ins) + len(importTx.Outs) + len(importTx.ImportedInputs) - 1 // - 1 for the output without addresses
 	assert.Equal(t, total, len(rosettaTransaction.Operations))
 
 	cntTxType, cntInputMeta, cntOutputMeta, cntMetaType := verifyRosettaTransaction(rosettaTransaction.Operations, OpImportAvax, OpTypeImport)
 
-	assert.Equal(t, 2, cntTxType)
+	assert.Equal(t, 3, cntTxType)
 	assert.Equal(t, 0, cntInputMeta)
-	assert.Equal(t, 1, cntOutputMeta)
+	assert.Equal(t, 2, cntOutputMeta)
 	assert.Equal(t, 1, cntMetaType)
 
 	assert.Equal(t, types.CoinSpent, rosettaTransaction.Operations[0].CoinChange.CoinAction)
 	assert.Nil(t, rosettaTransaction.Operations[1].CoinChange)
+
+	// Multisig outputs are reported with their owners during construction
+	multisigOp := rosettaTransaction.Operations[2]
+	assert.Equal(t, "P-fuji1xm0r37l6gyf2mly4pmzc0tz6wnwqkugedh95fk", multisigOp.Account.Address)
+	multisigMetadata, err := ParseOpMetadata(multisigOp.Metadata)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), multisigMetadata.Threshold)
+	assert.Equal(t, []string{
+		"P-fuji1xm0r37l6gyf2mly4pmzc0tz6wnwqkugedh95fk",
+		"P-fuji1fmragvegm5k26qzlt6vy0ghhdr508u6r4a5rxj",
+		"P-fuji1j3sw805usytrsymfwxxrcwfqguyarumn45cllj",
+	}, multisigMetadata.Owners)
 }
 
 func TestMapNonConstructionImportTx(t *testing.T) {
@@ -271,6 +287,170 @@ func TestMapNonConstructionExportTx(t *testing.T) {
 	assert.Equal(t, []*types.Operation{out}, exportOutputs)
 }
 
+func TestMapSubnetTxs(t *testing.T) {
+	baseTx, inputAccounts := buildBaseTx()
+	txID, _ := ids.FromString("2ZcdEpQjQF2RsTFYvmXKqLWNkpeZ8XwBaHVshHEYeA7zAaVAfc")
+	subnetID, _ := ids.FromString("2bRCr6B4MiEfSjidDwxDpdCyviwnfUVqB2HGwhm947w9YYqb7r")
+	assetID, _ := ids.FromString("U8iRqJoiJm8xZHAacmvYyZVwqQx6uDNtQeP3CQ6fcgQk3JqnK")
+	vmID, _ := ids.FromString("srEXiWaHuhNyGwPUi444Tu47ZEDwxTWrbQiuD7FmgSAQ6X7Dy")
+	fxID, _ := ids.FromString("spdxUxVJQbX85MGxMHbKw1sHxMnSqJ3QBzDyDYEP3h6TLuxqQ")
+	nodeID, _ := ids.NodeIDFromString("NodeID-BFa1padLXBj7VHa2JYvYGzcTBPQGjPhUy")
+	ownerAddr, _ := address.ParseToID("P-fuji1l022sue7g2kzvrcuxughl30xkss2cj0az3e5r2")
+	subnetAuth := &secp256k1fx.Input{SigIndices: []uint32{0}}
+
+	tests := []struct {
+		name     string
+		tx       txs.UnsignedTx
+		txType   string
+		metadata map[string]interface{}
+	}{
+		{
+			name: "create subnet",
+			tx: &txs.CreateSubnetTx{
+				BaseTx: baseTx,
+				Owner: &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ownerAddr},
+				},
+			},
+			txType: OpCreateSubnet,
+			metadata: map[string]interface{}{
+				MetadataSubnetID:        txID.String(),
+				MetadataSubnetOwners:    []string{"P-fuji1l022sue7g2kzvrcuxughl30xkss2cj0az3e5r2"},
+				MetadataSubnetThreshold: uint32(1),
+			},
+		},
+		{
+			name: "create chain",
+			tx: &txs.CreateChainTx{
+				BaseTx:      baseTx,
+				SubnetID:    subnetID,
+				ChainName:   "rosetta",
+				VMID:        vmID,
+				FxIDs:       []ids.ID{fxID},
+				GenesisData: []byte("{}"),
+				SubnetAuth:  subnetAuth,
+			},
+			txType: OpCreateChain,
+			metadata: map[string]interface{}{
+				MetadataSubnetID:  subnetID.String(),
+				MetadataChainName: "rosetta",
+				MetadataVMID:      vmID.String(),
+				MetadataFxIDs:     []string{fxID.String()},
+			},
+		},
+		{
+			name: "add subnet validator",
+			tx: &txs.AddSubnetValidatorTx{
+				BaseTx: baseTx,
+				Validator: validator.SubnetValidator{
+					Validator: validator.Validator{
+						NodeID: nodeID,
+						Start:  1656058022,
+						End:    1657872569,
+						Wght:   20,
+					},
+					Subnet: subnetID,
+				},
+				SubnetAuth: subnetAuth,
+			},
+			txType: OpAddSubnetValidator,
+			metadata: map[string]interface{}{
+				MetadataSubnetID:         subnetID.String(),
+				MetadataValidatorNodeID:  nodeID.String(),
+				MetadataValidatorWeight:  uint64(20),
+				MetadataStakingStartTime: uint64(1656058022),
+				MetadataStakingEndTime:   uint64(1657872569),
+			},
+		},
+		{
+			name: "remove subnet validator",
+			tx: &txs.RemoveSubnetValidatorTx{
+				BaseTx:     baseTx,
+				NodeID:     nodeID,
+				Subnet:     subnetID,
+				SubnetAuth: subnetAuth,
+			},
+			txType: OpRemoveSubnetValidator,
+			metadata: map[string]interface{}{
+				MetadataSubnetID:        subnetID.String(),
+				MetadataValidatorNodeID: nodeID.String(),
+			},
+		},
+		{
+			name: "transform subnet",
+			tx: &txs.TransformSubnetTx{
+				BaseTx:                   baseTx,
+				Subnet:                   subnetID,
+				AssetID:                  assetID,
+				InitialSupply:            1000,
+				MaximumSupply:            2000,
+				MinConsumptionRate:       100,
+				MaxConsumptionRate:       200,
+				MinValidatorStake:        10,
+				MaxValidatorStake:        100,
+				MinStakeDuration:         86400,
+				MaxStakeDuration:         31536000,
+				MinDelegationFee:         20000,
+				MinDelegatorStake:        5,
+				MaxValidatorWeightFactor: 5,
+				UptimeRequirement:        800000,
+				SubnetAuth:               subnetAuth,
+			},
+			txType: OpTransformSubnetValidator,
+			metadata: map[string]interface{}{
+				MetadataSubnetID: subnetID.String(),
+				MetadataAssetID:  assetID.String(),
+				MetadataRewardConfig: &SubnetRewardConfig{
+					InitialSupply:            1000,
+					MaximumSupply:            2000,
+					MinConsumptionRate:       100,
+					MaxConsumptionRate:       200,
+					MinValidatorStake:        10,
+					MaxValidatorStake:        100,
+					MinStakeDuration:         86400,
+					MaxStakeDuration:         31536000,
+					MinDelegationFee:         20000,
+					MinDelegatorStake:        5,
+					MaxValidatorWeightFactor: 5,
+					UptimeRequirement:        800000,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parser := NewTxParser(false, constants.FujiHRP, chainIDs, inputAccounts, nil)
+			rosettaTransaction, err := parser.Parse(txID, test.tx)
+			assert.Nil(t, err)
+
+			assert.Equal(t, 2, len(rosettaTransaction.Operations))
+			cntTxType, cntInputMeta, cntOutputMeta, _ := verifyRosettaTransaction(rosettaTransaction.Operations, test.txType, OpTypeOutput)
+			assert.Equal(t, 2, cntTxType)
+			assert.Equal(t, 1, cntInputMeta)
+			assert.Equal(t, 1, cntOutputMeta)
+
+			for key, value := range test.metadata {
+				assert.Equal(t, value, rosettaTransaction.Metadata[key], key)
+				assert.Equal(t, value, rosettaTransaction.Operations[0].Metadata[key], key)
+				assert.NotContains(t, rosettaTransaction.Operations[1].Metadata, key)
+			}
+			assert.Equal(t, test.txType, rosettaTransaction.Metadata[MetadataTxType])
+		})
+	}
+
+	t.Run("subnet id of created subnets is unknown during construction", func(t *testing.T) {
+		parser := NewTxParser(true, constants.FujiHRP, chainIDs, inputAccounts, nil)
+		rosettaTransaction, err := parser.Parse(ids.Empty, tests[0].tx)
+		assert.Nil(t, err)
+
+		assert.NotContains(t, rosettaTransaction.Metadata, MetadataSubnetID)
+		assert.NotContains(t, rosettaTransaction.Operations[0].Metadata, MetadataSubnetID)
+		assert.Equal(t, uint32(1), rosettaTransaction.Operations[0].Metadata[MetadataSubnetThreshold])
+	})
+}
+
 func verifyRosettaTransaction(operations []*types.Operation, txType string, metaType string) (int, int, int, int) {
 	cntOpInputMeta := 0
 	cntOpOutputMeta := 0
@@ -4,6 +4,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/coinbase/rosetta-sdk-go/types"
 )
 
 const (
@@ -35,12 +36,33 @@ const (
 	MetadataStakingStartTime = "staking_start_time"
 	MetadataStakingEndTime   = "staking_end_time"
 	MetadataMessage          = "message"
+	MetadataSubnetID         = "subnet_id"
+	MetadataSubnetOwners     = "subnet_owners"
+	MetadataSubnetThreshold  = "subnet_threshold"
+	MetadataChainName        = "chain_name"
+	MetadataVMID             = "vm_id"
+	MetadataFxIDs            = "fx_ids"
+	MetadataValidatorWeight  = "validator_weight"
+	MetadataAssetID          = "asset_id"
+	MetadataRewardConfig     = "reward_config"
 
 	SubAccountTypeSharedMemory       = "shared_memory"
 	SubAccountTypeUnlocked           = "unlocked"
 	SubAccountTypeLockedStakeable    = "locked_stakeable"
 	SubAccountTypeLockedNotStakeable = "locked_not_stakeable"
 	SubAccountTypeStaked             = "staked"
+	SubAccountTypeMultisig           = "multisig"
+
+	CoinTypeTransferOutput      = "transfer_output"
+	CoinTypeStakeableLockOutput = "stakeable_lock_output"
+
+	CallGetStakingPositions = "platform_getStakingPositions"
+	CallGetStakingRewards   = "platform_getStakingRewards"
+
+	StakingPositionValidator = "validator"
+	StakingPositionDelegator = "delegator"
+	StakingStatusCurrent     = "current"
+	StakingStatusPending     = "pending"
 )
 
 var (
@@ -49,12 +71,18 @@ var (
 		OpExportAvax,
 		OpAddValidator,
 		OpAddDelegator,
+		OpAddPermissionlessValidator,
+		OpAddPermissionlessDelegator,
 		OpRewardValidator,
 		OpCreateChain,
 		OpCreateSubnet,
 		OpAddSubnetValidator,
+		OpRemoveSubnetValidator,
+	}
+	CallMethods = []string{
+		CallGetStakingPositions,
+		CallGetStakingRewards,
 	}
-	CallMethods = []string{}
 )
 
 type OperationMetadata struct {
@@ -62,6 +90,35 @@ type OperationMetadata struct {
 	SigIndices []uint32 `json:"sig_indices,omitempty"`
 	Locktime   uint64   `json:"locktime"`
 	Threshold  uint32   `json:"threshold,omitempty"`
+
+	// StakeableLocktime is set for stakeable locked outputs, and for the inputs spending them
+	StakeableLocktime uint64 `json:"stakeable_locktime,omitempty"`
+
+	// Owners are the addresses of a multisig output. Multisig inputs are
+	// signed by the owners at SigIndices, which default to the first Threshold owners.
+	Owners []string `json:"owners,omitempty"`
+}
+
+// CoinsMetadata is the metadata of /account/coins responses
+type CoinsMetadata struct {
+	// Coins describes the output of each coin by coin identifier
+	Coins map[string]*CoinMetadata `json:"coins,omitempty"`
+}
+
+// CoinMetadata describes the output of a coin
+type CoinMetadata struct {
+	Type string `json:"type"`
+	// Owners are set for multisig coins only
+	Owners            []string `json:"owners,omitempty"`
+	Threshold         uint32   `json:"threshold"`
+	Locktime          uint64   `json:"locktime"`
+	StakeableLocktime uint64   `json:"stakeable_locktime,omitempty"`
+}
+
+// AccountCoinsMetadata is the account identifier metadata accepted by /account/coins
+type AccountCoinsMetadata struct {
+	// BlockIdentifier requests the coins of the account at a past block
+	BlockIdentifier *types.PartialBlockIdentifier `json:"block_identifier,omitempty"`
 }
 
 type ImportExportOptions struct {
@@ -78,6 +135,41 @@ type StakingOptions struct {
 	Locktime        uint64   `json:"locktime"`
 	Threshold       uint32   `json:"threshold"`
 	RewardAddresses []string `json:"reward_addresses"`
+
+	// Permissionless staking options. Validators and delegators of the
+	// primary network are added when SubnetID is empty, in which case
+	// validators must provide their BLS public key and proof of possession.
+	SubnetID                  string   `json:"subnet_id,omitempty"`
+	BLSPublicKey              string   `json:"bls_public_key,omitempty"`
+	BLSProofOfPossession      string   `json:"bls_proof_of_possession,omitempty"`
+	DelegationRewardAddresses []string `json:"delegation_reward_addresses,omitempty"`
+}
+
+// SubnetOptions are the options of the CreateSubnet, CreateChain,
+// AddSubnetValidator and RemoveSubnetValidator operations
+type SubnetOptions struct {
+	SubnetID string `json:"subnet_id"`
+	Memo     string `json:"memo"`
+
+	// CreateSubnet options, the threshold and locktime of the subnet owner
+	// being the threshold and locktime options
+	SubnetOwners []string `json:"subnet_owners"`
+
+	// CreateChain options, the genesis being hex encoded with a checksum like memos
+	ChainName string   `json:"chain_name"`
+	VMID      string   `json:"vm_id"`
+	FxIDs     []string `json:"fx_ids"`
+	Genesis   string   `json:"genesis"`
+
+	// AddSubnetValidator and RemoveSubnetValidator options
+	NodeID string `json:"node_id"`
+	Start  uint64 `json:"start"`
+	End    uint64 `json:"end"`
+	Weight uint64 `json:"weight"`
+
+	// SubnetAuthSigners are the subnet owner addresses authorizing the
+	// transaction, defaulting to the first threshold owners of the subnet
+	SubnetAuthSigners []string `json:"subnet_auth_signers"`
 }
 
 type Metadata struct {
@@ -86,6 +178,9 @@ type Metadata struct {
 	*ImportMetadata
 	*ExportMetadata
 	*StakingMetadata
+
+	// SubnetMetadata is not embedded as its fields overlap with StakingMetadata
+	SubnetMetadata *SubnetMetadata `json:"subnet,omitempty"`
 }
 
 type ImportMetadata struct {
@@ -106,6 +201,107 @@ type StakingMetadata struct {
 	Locktime        uint64   `json:"locktime"`
 	Threshold       uint32   `json:"threshold"`
 	Memo            string   `json:"memo"`
+
+	SubnetID                  string   `json:"subnet_id,omitempty"`
+	BLSPublicKey              string   `json:"bls_public_key,omitempty"`
+	BLSProofOfPossession      string   `json:"bls_proof_of_possession,omitempty"`
+	DelegationRewardAddresses []string `json:"delegation_reward_addresses,omitempty"`
+}
+
+type SubnetMetadata struct {
+	SubnetID string `json:"subnet_id,omitempty"`
+	Memo     string `json:"memo,omitempty"`
+
+	SubnetOwners []string `json:"subnet_owners,omitempty"`
+	Threshold    uint32   `json:"threshold,omitempty"`
+	Locktime     uint64   `json:"locktime,omitempty"`
+
+	ChainName string   `json:"chain_name,omitempty"`
+	VMID      string   `json:"vm_id,omitempty"`
+	FxIDs     []string `json:"fx_ids,omitempty"`
+	Genesis   string   `json:"genesis,omitempty"`
+
+	NodeID string `json:"node_id,omitempty"`
+	Start  uint64 `json:"start,omitempty"`
+	End    uint64 `json:"end,omitempty"`
+	Weight uint64 `json:"weight,omitempty"`
+
+	// SubnetAuthSigIndices are the indices of SubnetAuthSigners in the
+	// subnet owner addresses, signing after the inputs of the transaction
+	SubnetAuthSigIndices []uint32 `json:"subnet_auth_sig_indices,omitempty"`
+	SubnetAuthSigners    []string `json:"subnet_auth_signers,omitempty"`
+}
+
+// SubnetRewardConfig is the staking and reward configuration of a subnet
+// transformed into a permissionless subnet
+type SubnetRewardConfig struct {
+	InitialSupply            uint64 `json:"initial_supply"`
+	MaximumSupply            uint64 `json:"maximum_supply"`
+	MinConsumptionRate       uint64 `json:"min_consumption_rate"`
+	MaxConsumptionRate       uint64 `json:"max_consumption_rate"`
+	MinValidatorStake        uint64 `json:"min_validator_stake"`
+	MaxValidatorStake        uint64 `json:"max_validator_stake"`
+	MinStakeDuration         uint32 `json:"min_stake_duration"`
+	MaxStakeDuration         uint32 `json:"max_stake_duration"`
+	MinDelegationFee         uint32 `json:"min_delegation_fee"`
+	MinDelegatorStake        uint64 `json:"min_delegator_stake"`
+	MaxValidatorWeightFactor byte   `json:"max_validator_weight_factor"`
+	UptimeRequirement        uint32 `json:"uptime_requirement"`
+}
+
+// GetStakingPositionsInput is the input of the platform_getStakingPositions call
+type GetStakingPositionsInput struct {
+	Addresses []string `json:"addresses"`
+}
+
+// GetStakingPositionsOutput is the result of the platform_getStakingPositions call
+type GetStakingPositionsOutput struct {
+	// Staked is the amount of AVAX staked by the addresses, as reported by platform.getStake
+	Staked    string             `json:"staked"`
+	Positions []*StakingPosition `json:"positions"`
+}
+
+// StakingPosition is a Primary Network validation or delegation rewarding one of the requested addresses
+type StakingPosition struct {
+	TxID        string `json:"tx_id"`
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+	NodeID      string `json:"node_id"`
+	StartTime   uint64 `json:"start_time"`
+	EndTime     uint64 `json:"end_time"`
+	StakeAmount string `json:"stake_amount"`
+
+	// DelegationFee is the percentage of the delegator rewards kept by validators
+	DelegationFee float32 `json:"delegation_fee,omitempty"`
+	// PotentialReward is only known for current positions
+	PotentialReward       string       `json:"potential_reward,omitempty"`
+	RewardOwner           *RewardOwner `json:"reward_owner,omitempty"`
+	DelegationRewardOwner *RewardOwner `json:"delegation_reward_owner,omitempty"`
+}
+
+// RewardOwner is the owner of the rewards of a staking position
+type RewardOwner struct {
+	Addresses []string `json:"addresses"`
+	Threshold uint32   `json:"threshold"`
+	Locktime  uint64   `json:"locktime"`
+}
+
+// GetStakingRewardsInput is the input of the platform_getStakingRewards call
+type GetStakingRewardsInput struct {
+	TxIDs []string `json:"tx_ids"`
+}
+
+// GetStakingRewardsOutput is the result of the platform_getStakingRewards call
+type GetStakingRewardsOutput struct {
+	Rewards []*StakingReward `json:"rewards"`
+}
+
+// StakingReward is a reward UTXO paid by a staking transaction once its position ended
+type StakingReward struct {
+	TxID           string       `json:"tx_id"`
+	CoinIdentifier string       `json:"coin_identifier"`
+	Amount         string       `json:"amount"`
+	Owner          *RewardOwner `json:"owner"`
 }
 
 type DependencyTx struct {
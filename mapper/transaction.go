@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
@@ -11,26 +12,67 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/coreth/accounts/abi"
 	ethtypes "github.com/ava-labs/coreth/core/types"
 	"github.com/ava-labs/coreth/plugin/evm"
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	clientTypes "github.com/ava-labs/avalanche-rosetta/client"
 )
 
 const (
-	topicsInErc721Transfer = 4
-	topicsInErc20Transfer  = 3
+	topicsInErc721Transfer  = 4
+	topicsInErc20Transfer   = 3
+	topicsInErc1155Transfer = 4
+	topicsInWrappedNative   = 2
+
+	// selectorLength is the length of the function selector prefixing call inputs
+	selectorLength = 4
 
 	transferMethodHash = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	// ERC-1155 TransferSingle(address,address,address,uint256,uint256) and
+	// TransferBatch(address,address,address,uint256[],uint256[]) events
+	transferSingleMethodHash = "0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62"
+	transferBatchMethodHash  = "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"
+	// Deposit(address,uint256) and Withdrawal(address,uint256) events of wrapped
+	// native tokens such as WAVAX, which do not emit a Transfer event
+	depositMethodHash    = "0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c"
+	withdrawalMethodHash = "0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65"
 )
 
 var (
 	X2crate     = big.NewInt(1000000000)
 	zeroAddress = common.Address{}
+
+	errInvalidErc1155Transfer = errors.New("invalid ERC1155 transfer data")
+
+	uint256ArrayType, _      = abi.NewType("uint256[]", "", nil)
+	erc1155BatchTransferArgs = abi.Arguments{{Type: uint256ArrayType}, {Type: uint256ArrayType}}
 )
 
+// TransactionOptions configures how C-chain transactions are mapped
+type TransactionOptions struct {
+	// AnalyticsMode maps the transfers of all tokens, rather than only the
+	// transfers of the whitelisted tokens
+	AnalyticsMode  bool
+	TokenWhiteList []string
+
+	// IncludeUnknownTokens maps the transfers of tokens without metadata
+	IncludeUnknownTokens bool
+
+	// WrappedNativeTokens lists the contracts whose Deposit and Withdrawal
+	// events mint and burn tokens, such as WAVAX
+	WrappedNativeTokens []string
+
+	// IncludeZeroValueCalls maps zero value internal calls to operations
+	IncludeZeroValueCalls bool
+
+	// MetadataVerbosity controls the transaction metadata
+	MetadataVerbosity string
+}
+
 func Transaction(
 	header *ethtypes.Header,
 	tx *ethtypes.Transaction,
@@ -39,78 +81,88 @@ func Transaction(
 	trace *clientTypes.Call,
 	flattenedTrace []*clientTypes.FlatCall,
 	client clientTypes.Client,
-	isAnalyticsMode bool,
-	standardModeWhiteList []string,
-	includeUnknownTokens bool,
+	options TransactionOptions,
 ) (*types.Transaction, error) {
-	ops := []*types.Operation{}
-	sender := msg.From()
-	feeReceiver := &header.Coinbase
-
-	txFee := new(big.Int).SetUint64(receipt.GasUsed)
-	txFee = txFee.Mul(txFee, msg.GasPrice())
-
-	feeOps := []*types.Operation{
-		{
-			OperationIdentifier: &types.OperationIdentifier{
-				Index: 0,
-			},
-			Type:    OpFee,
-			Status:  types.String(StatusSuccess),
-			Account: Account(&sender),
-			Amount:  AvaxAmount(new(big.Int).Neg(txFee)),
-		},
-		{
-			OperationIdentifier: &types.OperationIdentifier{
-				Index: 1,
-			},
-			RelatedOperations: []*types.OperationIdentifier{
-				{
-					Index: 0,
-				},
-			},
-			Type:    OpFee,
-			Status:  types.String(StatusSuccess),
-			Account: Account(feeReceiver),
-			Amount:  AvaxAmount(txFee),
-		},
+	ops, err := feeOps(header, tx, msg, receipt)
+	if err != nil {
+		return nil, err
 	}
 
-	ops = append(ops, feeOps...)
-
-	traceOps := traceOps(flattenedTrace, len(feeOps))
+	traceOps := traceOps(flattenedTrace, len(ops), options.IncludeZeroValueCalls)
 	ops = append(ops, traceOps...)
 	for _, log := range receipt.Logs {
 		// Only check transfer logs
-		if len(log.Topics) == 0 || log.Topics[0].String() != transferMethodHash {
+		if len(log.Topics) == 0 {
+			continue
+		}
+		topic := log.Topics[0].String()
+		isWrappedNativeLog := (topic == depositMethodHash || topic == withdrawalMethodHash) &&
+			EqualFoldContains(options.WrappedNativeTokens, log.Address.String())
+		if topic != transferMethodHash && topic != transferSingleMethodHash && topic != transferBatchMethodHash && !isWrappedNativeLog {
 			continue
 		}
 
 		// If in standard mode, token address must be whitelisted
-		if !isAnalyticsMode && !EqualFoldContains(standardModeWhiteList, log.Address.String()) {
+		if !options.AnalyticsMode && !EqualFoldContains(options.TokenWhiteList, log.Address.String()) {
 			continue
 		}
 
-		switch len(log.Topics) {
-		case topicsInErc721Transfer:
+		switch {
+		case isWrappedNativeLog:
+			if len(log.Topics) != topicsInWrappedNative {
+				continue
+			}
+
+			symbol, decimals, err := client.GetContractInfo(log.Address, true)
+			if err != nil {
+				return nil, err
+			}
+
+			if symbol == clientTypes.UnknownERC20Symbol && !options.IncludeUnknownTokens {
+				continue
+			}
+
+			wrappedNativeOps := wrappedNativeOps(log, ToCurrency(symbol, decimals, log.Address), int64(len(ops)))
+			ops = append(ops, wrappedNativeOps...)
+		case topic == transferSingleMethodHash || topic == transferBatchMethodHash:
+			if len(log.Topics) != topicsInErc1155Transfer {
+				continue
+			}
+
 			symbol, _, err := client.GetContractInfo(log.Address, false)
 			if err != nil {
 				return nil, err
 			}
 
-			if symbol == clientTypes.UnknownERC721Symbol && !includeUnknownTokens {
+			if symbol == clientTypes.UnknownERC721Symbol && !options.IncludeUnknownTokens {
+				continue
+			}
+
+			erc1155Ops, err := erc1155Ops(log, int64(len(ops)))
+			if err != nil {
+				// Malformed events of non compliant contracts are ignored
+				continue
+			}
+			ops = append(ops, erc1155Ops...)
+		case len(log.Topics) == topicsInErc721Transfer:
+			symbol, _, err := client.GetContractInfo(log.Address, false)
+			if err != nil {
+				return nil, err
+			}
+
+			if symbol == clientTypes.UnknownERC721Symbol && !options.IncludeUnknownTokens {
 				continue
 			}
 
 			erc721Ops := erc721Ops(log, int64(len(ops)))
 			ops = append(ops, erc721Ops...)
-		case topicsInErc20Transfer:
+		case len(log.Topics) == topicsInErc20Transfer:
 			symbol, decimals, err := client.GetContractInfo(log.Address, true)
 			if err != nil {
 				return nil, err
 			}
 
-			if symbol == clientTypes.UnknownERC20Symbol && !includeUnknownTokens {
+			if symbol == clientTypes.UnknownERC20Symbol && !options.IncludeUnknownTokens {
 				continue
 			}
 
@@ -125,14 +177,110 @@ func Transaction(
 			Hash: tx.Hash().String(),
 		},
 		Operations: ops,
-		Metadata: map[string]interface{}{
+		Metadata:   transactionMetadata(tx, receipt, trace, options.MetadataVerbosity),
+	}, nil
+}
+
+// transactionMetadata returns the metadata of the transaction for the given
+// verbosity. The summary leaves out the receipt logs and the call trace, which
+// make up most of the size of busy blocks.
+func transactionMetadata(
+	tx *ethtypes.Transaction,
+	receipt *ethtypes.Receipt,
+	trace *clientTypes.Call,
+	verbosity string,
+) map[string]interface{} {
+	switch verbosity {
+	case MetadataVerbosityNone:
+		return nil
+	case MetadataVerbositySummary:
+		return map[string]interface{}{
+			"gas":       tx.Gas(),
+			"gas_price": tx.GasPrice().String(),
+			"gas_used":  receipt.GasUsed,
+			"status":    receipt.Status,
+			"type":      tx.Type(),
+		}
+	default:
+		return map[string]interface{}{
 			"gas":       tx.Gas(),
 			"gas_price": tx.GasPrice().String(),
 			"receipt":   receipt,
 			"trace":     trace,
 			"type":      tx.Type(),
-		},
-	}, nil
+		}
+	}
+}
+
+// feeOps returns the operations charging the transaction fee to the sender.
+//
+// The sender is debited gasUsed * effectiveGasPrice. Only the effective tip
+// accrues to the coinbase, the base fee portion is burned and emitted as an
+// unbalanced FEE_BURN debit of the sender. Blocks without a base fee credit
+// the whole fee to the coinbase.
+func feeOps(
+	header *ethtypes.Header,
+	tx *ethtypes.Transaction,
+	msg *ethtypes.Message,
+	receipt *ethtypes.Receipt,
+) ([]*types.Operation, error) {
+	ops := []*types.Operation{}
+	sender := msg.From()
+	gasUsed := new(big.Int).SetUint64(receipt.GasUsed)
+
+	txFee := new(big.Int).Mul(gasUsed, msg.GasPrice())
+	burntFee := new(big.Int)
+	if header.BaseFee != nil {
+		tip, err := tx.EffectiveGasTip(header.BaseFee)
+		if err != nil {
+			return nil, err
+		}
+
+		burntFee = new(big.Int).Sub(txFee, new(big.Int).Mul(gasUsed, tip))
+	}
+	tipFee := new(big.Int).Sub(txFee, burntFee)
+
+	if tipFee.Sign() > 0 || burntFee.Sign() == 0 {
+		ops = append(ops,
+			&types.Operation{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 0,
+				},
+				Type:    OpFee,
+				Status:  types.String(StatusSuccess),
+				Account: Account(&sender),
+				Amount:  AvaxAmount(new(big.Int).Neg(tipFee)),
+			},
+			&types.Operation{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 1,
+				},
+				RelatedOperations: []*types.OperationIdentifier{
+					{
+						Index: 0,
+					},
+				},
+				Type:    OpFee,
+				Status:  types.String(StatusSuccess),
+				Account: Account(&header.Coinbase),
+				Amount:  AvaxAmount(tipFee),
+			},
+		)
+	}
+
+	if burntFee.Sign() > 0 {
+		ops = append(ops, &types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: int64(len(ops)),
+			},
+			Type:    OpFeeBurn,
+			Status:  types.String(StatusSuccess),
+			Account: Account(&sender),
+			Amount:  AvaxAmount(new(big.Int).Neg(burntFee)),
+		})
+	}
+
+	return ops, nil
 }
 
 func crossChainTransaction(
@@ -350,7 +498,24 @@ func MempoolTransactionsIDs(accountMap clientTypes.TxAccountMap) []*types.Transa
 	return result
 }
 
-func traceOps(trace []*clientTypes.FlatCall, startIndex int) []*types.Operation {
+// addCallMetadata describes the call frame in the operation metadata, so that
+// the call graph of a transaction can be rebuilt from its operations
+func addCallMetadata(metadata map[string]interface{}, call *clientTypes.FlatCall) {
+	metadata[CallDepthMetadata] = call.Depth
+	if call.GasUsed != nil {
+		metadata[GasUsedMetadata] = call.GasUsed.String()
+	}
+	if len(call.Input) >= selectorLength {
+		metadata[InputSelectorMetadata] = hexutil.Encode(call.Input[:selectorLength])
+	}
+	if call.Revert {
+		if reason, err := abi.UnpackRevert(call.Output); err == nil {
+			metadata[RevertReasonMetadata] = reason
+		}
+	}
+}
+
+func traceOps(trace []*clientTypes.FlatCall, startIndex int, includeZeroValueCalls bool) []*types.Operation {
 	ops := []*types.Operation{}
 	if len(trace) == 0 {
 		return ops
@@ -365,18 +530,21 @@ func traceOps(trace []*clientTypes.FlatCall, startIndex int) []*types.Operation
 			opStatus = StatusFailure
 			metadata["error"] = call.Error
 		}
+		if includeZeroValueCalls {
+			addCallMetadata(metadata, call)
+		}
 
 		var zeroValue bool
 		if call.Value.Sign() == 0 {
 			zeroValue = true
 		}
 
-		// Skip all 0 value CallType operations (TODO: make optional to include)
+		// Skip all 0 value CallType operations unless configured to include them
 		//
 		// We can't continue here because we may need to adjust our destroyed
 		// accounts map if a CallTYpe operation resurrects an account.
 		shouldAdd := true
-		if zeroValue && CallType(call.Type) {
+		if zeroValue && CallType(call.Type) && !includeZeroValueCalls {
 			shouldAdd = false
 		}
 
@@ -560,6 +728,34 @@ func erc20Ops(transferLog *ethtypes.Log, currency *types.Currency, opsLen int64)
 	}}
 }
 
+// wrappedNativeOps returns the operation minting the tokens of a wrapped native
+// token deposit, or burning the tokens of a withdrawal
+func wrappedNativeOps(wrapLog *ethtypes.Log, currency *types.Currency, opsLen int64) []*types.Operation {
+	account := common.BytesToAddress(wrapLog.Topics[1].Bytes())
+
+	if wrapLog.Topics[0].String() == depositMethodHash {
+		return []*types.Operation{{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: opsLen,
+			},
+			Status:  types.String(StatusSuccess),
+			Type:    OpErc20Mint,
+			Amount:  Erc20Amount(wrapLog.Data, currency, false),
+			Account: Account(&account),
+		}}
+	}
+
+	return []*types.Operation{{
+		OperationIdentifier: &types.OperationIdentifier{
+			Index: opsLen,
+		},
+		Status:  types.String(StatusSuccess),
+		Type:    OpErc20Burn,
+		Amount:  Erc20Amount(wrapLog.Data, currency, true),
+		Account: Account(&account),
+	}}
+}
+
 func erc721Ops(transferLog *ethtypes.Log, opsLen int64) []*types.Operation {
 	fromAddress := common.BytesToAddress(transferLog.Topics[1].Bytes())
 	toAddress := common.BytesToAddress(transferLog.Topics[2].Bytes())
@@ -619,3 +815,103 @@ func erc721Ops(transferLog *ethtypes.Log, opsLen int64) []*types.Operation {
 		},
 	}}
 }
+
+// erc1155Ops returns the operations of an ERC-1155 TransferSingle or TransferBatch
+// event, batches being expanded into the operations of each of their token IDs
+func erc1155Ops(transferLog *ethtypes.Log, opsLen int64) ([]*types.Operation, error) {
+	fromAddress := common.BytesToAddress(transferLog.Topics[2].Bytes())
+	toAddress := common.BytesToAddress(transferLog.Topics[3].Bytes())
+
+	tokenIDs, amounts, err := erc1155Transfers(transferLog)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []*types.Operation{}
+	for i, tokenID := range tokenIDs {
+		index := opsLen + int64(len(ops))
+		metadata := map[string]interface{}{
+			ContractAddressMetadata:   transferLog.Address.String(),
+			IndexTransferredMetadata:  common.BigToHash(tokenID).String(),
+			AmountTransferredMetadata: amounts[i].String(),
+		}
+
+		switch {
+		// Mint
+		case fromAddress == zeroAddress:
+			ops = append(ops, &types.Operation{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: index,
+				},
+				Status:   types.String(StatusSuccess),
+				Type:     OpErc1155Mint,
+				Account:  Account(&toAddress),
+				Metadata: metadata,
+			})
+		// Burn
+		case toAddress == zeroAddress:
+			ops = append(ops, &types.Operation{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: index,
+				},
+				Status:   types.String(StatusSuccess),
+				Type:     OpErc1155Burn,
+				Account:  Account(&fromAddress),
+				Metadata: metadata,
+			})
+		default:
+			ops = append(ops, &types.Operation{
+				// Send
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: index,
+				},
+				Status:   types.String(StatusSuccess),
+				Type:     OpErc1155TransferSender,
+				Account:  Account(&fromAddress),
+				Metadata: metadata,
+			}, &types.Operation{
+				// Receive
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: index + 1,
+				},
+				Status:   types.String(StatusSuccess),
+				Type:     OpErc1155TransferReceive,
+				Account:  Account(&toAddress),
+				Metadata: metadata,
+				RelatedOperations: []*types.OperationIdentifier{
+					{
+						Index: index,
+					},
+				},
+			})
+		}
+	}
+
+	return ops, nil
+}
+
+// erc1155Transfers decodes the token IDs and amounts of an ERC-1155 transfer event
+func erc1155Transfers(transferLog *ethtypes.Log) ([]*big.Int, []*big.Int, error) {
+	if transferLog.Topics[0].String() == transferSingleMethodHash {
+		if len(transferLog.Data) != 2*common.HashLength {
+			return nil, nil, errInvalidErc1155Transfer
+		}
+		tokenID := new(big.Int).SetBytes(transferLog.Data[:common.HashLength])
+		amount := new(big.Int).SetBytes(transferLog.Data[common.HashLength:])
+		return []*big.Int{tokenID}, []*big.Int{amount}, nil
+	}
+
+	values, err := erc1155BatchTransferArgs.Unpack(transferLog.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokenIDs, ok := values[0].([]*big.Int)
+	if !ok {
+		return nil, nil, errInvalidErc1155Transfer
+	}
+	amounts, ok := values[1].([]*big.Int)
+	if !ok || len(amounts) != len(tokenIDs) {
+		return nil, nil, errInvalidErc1155Transfer
+	}
+	return tokenIDs, amounts, nil
+}
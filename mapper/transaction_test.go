@@ -2,6 +2,8 @@ package mapper
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/ava-labs/avalanchego/ids"
@@ -10,7 +12,10 @@ import (
 	"github.com/ava-labs/coreth/plugin/evm"
 	"github.com/coinbase/rosetta-sdk-go/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+
+	clientTypes "github.com/ava-labs/avalanche-rosetta/client"
 )
 
 var WAVAX = &types.Currency{
@@ -27,6 +32,142 @@ func TestZeroAddress(t *testing.T) {
 	})
 }
 
+func TestFeeOps(t *testing.T) {
+	var (
+		chainID  = big.NewInt(43113)
+		signer   = ethtypes.LatestSignerForChainID(chainID)
+		key, _   = ethcrypto.HexToECDSA("b6b15c8cb491557369f3c7d2c287b053eb229daa9c22138887752191c9520659")
+		to       = ethcommon.HexToAddress("0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d")
+		coinbase = ethcommon.HexToAddress("0x0100000000000000000000000000000000000000")
+		receipt  = &ethtypes.Receipt{GasUsed: 21000}
+	)
+
+	newMessage := func(t *testing.T, inner ethtypes.TxData, baseFee *big.Int) (*ethtypes.Transaction, *ethtypes.Message) {
+		tx, err := ethtypes.SignNewTx(key, signer, inner)
+		assert.Nil(t, err)
+
+		msg, err := tx.AsMessage(signer, baseFee)
+		assert.Nil(t, err)
+
+		return tx, &msg
+	}
+
+	feeOp := func(index int64, address ethcommon.Address, value int64) *types.Operation {
+		op := &types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: index,
+			},
+			Type:    OpFee,
+			Status:  types.String(StatusSuccess),
+			Account: Account(&address),
+			Amount:  AvaxAmount(big.NewInt(value)),
+		}
+		if value > 0 {
+			op.RelatedOperations = []*types.OperationIdentifier{{Index: index - 1}}
+		}
+		return op
+	}
+
+	burnOp := func(index int64, address ethcommon.Address, value int64) *types.Operation {
+		return &types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: index,
+			},
+			Type:    OpFeeBurn,
+			Status:  types.String(StatusSuccess),
+			Account: Account(&address),
+			Amount:  AvaxAmount(big.NewInt(value)),
+		}
+	}
+
+	t.Run("legacy tx without base fee", func(t *testing.T) {
+		header := &ethtypes.Header{Coinbase: coinbase}
+		tx, msg := newMessage(t, &ethtypes.LegacyTx{
+			To:       &to,
+			Gas:      21000,
+			GasPrice: big.NewInt(225),
+		}, nil)
+
+		ops, err := feeOps(header, tx, msg, receipt)
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Operation{
+			feeOp(0, msg.From(), -4725000),
+			feeOp(1, coinbase, 4725000),
+		}, ops)
+	})
+
+	t.Run("legacy tx with base fee", func(t *testing.T) {
+		header := &ethtypes.Header{Coinbase: coinbase, BaseFee: big.NewInt(200)}
+		tx, msg := newMessage(t, &ethtypes.LegacyTx{
+			To:       &to,
+			Gas:      21000,
+			GasPrice: big.NewInt(225),
+		}, header.BaseFee)
+
+		ops, err := feeOps(header, tx, msg, receipt)
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Operation{
+			feeOp(0, msg.From(), -525000),
+			feeOp(1, coinbase, 525000),
+			burnOp(2, msg.From(), -4200000),
+		}, ops)
+	})
+
+	t.Run("access list tx with base fee", func(t *testing.T) {
+		header := &ethtypes.Header{Coinbase: coinbase, BaseFee: big.NewInt(225)}
+		tx, msg := newMessage(t, &ethtypes.AccessListTx{
+			ChainID:  chainID,
+			To:       &to,
+			Gas:      21000,
+			GasPrice: big.NewInt(225),
+		}, header.BaseFee)
+
+		ops, err := feeOps(header, tx, msg, receipt)
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Operation{
+			burnOp(0, msg.From(), -4725000),
+		}, ops)
+	})
+
+	t.Run("dynamic fee tx capped by tip", func(t *testing.T) {
+		header := &ethtypes.Header{Coinbase: coinbase, BaseFee: big.NewInt(200)}
+		tx, msg := newMessage(t, &ethtypes.DynamicFeeTx{
+			ChainID:   chainID,
+			To:        &to,
+			Gas:       21000,
+			GasTipCap: big.NewInt(2),
+			GasFeeCap: big.NewInt(300),
+		}, header.BaseFee)
+
+		ops, err := feeOps(header, tx, msg, receipt)
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Operation{
+			feeOp(0, msg.From(), -42000),
+			feeOp(1, coinbase, 42000),
+			burnOp(2, msg.From(), -4200000),
+		}, ops)
+	})
+
+	t.Run("dynamic fee tx capped by fee cap", func(t *testing.T) {
+		header := &ethtypes.Header{Coinbase: coinbase, BaseFee: big.NewInt(200)}
+		tx, msg := newMessage(t, &ethtypes.DynamicFeeTx{
+			ChainID:   chainID,
+			To:        &to,
+			Gas:       21000,
+			GasTipCap: big.NewInt(50),
+			GasFeeCap: big.NewInt(210),
+		}, header.BaseFee)
+
+		ops, err := feeOps(header, tx, msg, receipt)
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Operation{
+			feeOp(0, msg.From(), -210000),
+			feeOp(1, coinbase, 210000),
+			burnOp(2, msg.From(), -4200000),
+		}, ops)
+	})
+}
+
 func TestERC20Ops(t *testing.T) {
 	t.Run("transfer op", func(t *testing.T) {
 		log := &ethtypes.Log{
@@ -135,6 +276,253 @@ func TestERC20Ops(t *testing.T) {
 	})
 }
 
+func TestWrappedNativeOps(t *testing.T) {
+	t.Run("event signatures", func(t *testing.T) {
+		assert.Equal(t, depositMethodHash, ethcrypto.Keccak256Hash([]byte("Deposit(address,uint256)")).String())
+		assert.Equal(t, withdrawalMethodHash, ethcrypto.Keccak256Hash([]byte("Withdrawal(address,uint256)")).String())
+	})
+
+	t.Run("deposit op", func(t *testing.T) {
+		log := &ethtypes.Log{
+			Address: ethcommon.HexToAddress(MainnetWAVAXAddress),
+			Topics: []ethcommon.Hash{
+				ethcommon.HexToHash(depositMethodHash),
+				ethcommon.HexToHash("0x000000000000000000000000f1b77573a8525acfa116a785092d1ba90d96bf37"),
+			},
+			Data: ethcommon.BigToHash(big.NewInt(1_000_000)).Bytes(),
+		}
+
+		assert.Equal(t, []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 1,
+				},
+				Type:   OpErc20Mint,
+				Status: types.String(StatusSuccess),
+				Account: &types.AccountIdentifier{
+					Address: "0xf1B77573A8525aCfa116a785092d1Ba90D96BF37",
+				},
+				Amount: &types.Amount{
+					Value:    "1000000",
+					Currency: WAVAX,
+				},
+			},
+		}, wrappedNativeOps(log, WAVAX, 1))
+	})
+
+	t.Run("withdrawal op", func(t *testing.T) {
+		log := &ethtypes.Log{
+			Address: ethcommon.HexToAddress(MainnetWAVAXAddress),
+			Topics: []ethcommon.Hash{
+				ethcommon.HexToHash(withdrawalMethodHash),
+				ethcommon.HexToHash("0x000000000000000000000000f1b77573a8525acfa116a785092d1ba90d96bf37"),
+			},
+			Data: ethcommon.BigToHash(big.NewInt(1_000_000)).Bytes(),
+		}
+
+		assert.Equal(t, []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 1,
+				},
+				Type:   OpErc20Burn,
+				Status: types.String(StatusSuccess),
+				Account: &types.AccountIdentifier{
+					Address: "0xf1B77573A8525aCfa116a785092d1Ba90D96BF37",
+				},
+				Amount: &types.Amount{
+					Value:    "-1000000",
+					Currency: WAVAX,
+				},
+			},
+		}, wrappedNativeOps(log, WAVAX, 1))
+	})
+}
+
+func TestTraceOps(t *testing.T) {
+	from := ethcommon.HexToAddress("0xf1B77573A8525aCfa116a785092d1Ba90D96BF37")
+	to := ethcommon.HexToAddress("0x7A6cA65A4D1F7AB6fD47d4C5d02E8CBc4c7e8E4b")
+	// Error(string) revert data with the "nope" reason
+	revertOutput, _ := hex.DecodeString(
+		"08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000004" +
+			"6e6f706500000000000000000000000000000000000000000000000000000000",
+	)
+	trace := []*clientTypes.FlatCall{
+		{
+			Type:    OpCall,
+			From:    from,
+			To:      to,
+			Value:   big.NewInt(100),
+			GasUsed: big.NewInt(21_000),
+			Input:   []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01},
+		},
+		{
+			Type:    OpStaticCall,
+			From:    to,
+			To:      from,
+			Value:   big.NewInt(0),
+			GasUsed: big.NewInt(500),
+			Input:   []byte{0x70, 0xa0, 0x82, 0x31},
+			Output:  revertOutput,
+			Revert:  true,
+			Error:   "execution reverted",
+			Depth:   1,
+		},
+	}
+
+	t.Run("skips zero value calls by default", func(t *testing.T) {
+		assert.Equal(t, []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 1},
+				Type:                OpCall,
+				Status:              types.String(StatusSuccess),
+				Account:             &types.AccountIdentifier{Address: from.String()},
+				Amount:              &types.Amount{Value: "-100", Currency: AvaxCurrency},
+				Metadata:            map[string]interface{}{},
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 2},
+				RelatedOperations:   []*types.OperationIdentifier{{Index: 1}},
+				Type:                OpCall,
+				Status:              types.String(StatusSuccess),
+				Account:             &types.AccountIdentifier{Address: to.String()},
+				Amount:              &types.Amount{Value: "100", Currency: AvaxCurrency},
+				Metadata:            map[string]interface{}{},
+			},
+		}, traceOps(trace, 1, false))
+	})
+
+	t.Run("includes zero value calls", func(t *testing.T) {
+		callMetadata := map[string]interface{}{
+			CallDepthMetadata:     0,
+			GasUsedMetadata:       "21000",
+			InputSelectorMetadata: "0xa9059cbb",
+		}
+		staticCallMetadata := map[string]interface{}{
+			"error":               "execution reverted",
+			CallDepthMetadata:     1,
+			GasUsedMetadata:       "500",
+			InputSelectorMetadata: "0x70a08231",
+			RevertReasonMetadata:  "nope",
+		}
+
+		assert.Equal(t, []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 1},
+				Type:                OpCall,
+				Status:              types.String(StatusSuccess),
+				Account:             &types.AccountIdentifier{Address: from.String()},
+				Amount:              &types.Amount{Value: "-100", Currency: AvaxCurrency},
+				Metadata:            callMetadata,
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 2},
+				RelatedOperations:   []*types.OperationIdentifier{{Index: 1}},
+				Type:                OpCall,
+				Status:              types.String(StatusSuccess),
+				Account:             &types.AccountIdentifier{Address: to.String()},
+				Amount:              &types.Amount{Value: "100", Currency: AvaxCurrency},
+				Metadata:            callMetadata,
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 3},
+				Type:                OpStaticCall,
+				Status:              types.String(StatusFailure),
+				Account:             &types.AccountIdentifier{Address: to.String()},
+				Metadata:            staticCallMetadata,
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 4},
+				RelatedOperations:   []*types.OperationIdentifier{{Index: 3}},
+				Type:                OpStaticCall,
+				Status:              types.String(StatusFailure),
+				Account:             &types.AccountIdentifier{Address: from.String()},
+				Metadata:            staticCallMetadata,
+			},
+		}, traceOps(trace, 1, true))
+	})
+}
+
+func TestTransactionMetadata(t *testing.T) {
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    1,
+		Gas:      21_000,
+		GasPrice: big.NewInt(25_000_000_000),
+	})
+	receipt := &ethtypes.Receipt{
+		Status:  ethtypes.ReceiptStatusSuccessful,
+		GasUsed: 21_000,
+	}
+	trace := &clientTypes.Call{Type: OpCall}
+
+	t.Run("none", func(t *testing.T) {
+		assert.Nil(t, transactionMetadata(tx, receipt, trace, MetadataVerbosityNone))
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		assert.Equal(t, map[string]interface{}{
+			"gas":       uint64(21_000),
+			"gas_price": "25000000000",
+			"gas_used":  uint64(21_000),
+			"status":    ethtypes.ReceiptStatusSuccessful,
+			"type":      uint8(ethtypes.LegacyTxType),
+		}, transactionMetadata(tx, receipt, trace, MetadataVerbositySummary))
+	})
+
+	t.Run("full", func(t *testing.T) {
+		assert.Equal(t, map[string]interface{}{
+			"gas":       uint64(21_000),
+			"gas_price": "25000000000",
+			"receipt":   receipt,
+			"trace":     trace,
+			"type":      uint8(ethtypes.LegacyTxType),
+		}, transactionMetadata(tx, receipt, trace, MetadataVerbosityFull))
+	})
+
+	t.Run("full trace serialization is unchanged", func(t *testing.T) {
+		var trace clientTypes.Call
+		err := json.Unmarshal([]byte(`{
+			"type": "CALL",
+			"from": "0x0000000000000000000000000000000000000001",
+			"to": "0x0000000000000000000000000000000000000002",
+			"value": "0x1",
+			"gasUsed": "0x5208",
+			"input": "0xa9059cbb",
+			"output": "0x01",
+			"calls": [{
+				"type": "STATICCALL",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000003",
+				"input": "0x70a08231"
+			}]
+		}`), &trace)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0xa9, 0x05, 0x9c, 0xbb}, []byte(trace.Input))
+		assert.Equal(t, []byte{0x70, 0xa0, 0x82, 0x31}, []byte(trace.Calls[0].Input))
+
+		metadata, err := json.Marshal(transactionMetadata(tx, receipt, &trace, MetadataVerbosityFull)["trace"])
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"type": "CALL",
+			"from": "0x0000000000000000000000000000000000000001",
+			"to": "0x0000000000000000000000000000000000000002",
+			"value": "0x1",
+			"gasUsed": "0x5208",
+			"revert": false,
+			"calls": [{
+				"type": "STATICCALL",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000003",
+				"value": null,
+				"gasUsed": null,
+				"revert": false
+			}]
+		}`, string(metadata))
+	})
+}
+
 func TestERC721Ops(t *testing.T) {
 	t.Run("transfer op", func(t *testing.T) {
 		log := &ethtypes.Log{
@@ -243,6 +631,147 @@ func TestERC721Ops(t *testing.T) {
 	})
 }
 
+func TestERC1155Ops(t *testing.T) {
+	contract := ethcommon.HexToAddress("0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7")
+	operator := ethcommon.HexToHash("0x0000000000000000000000001111111111111111111111111111111111111111")
+	from := ethcommon.HexToHash("0x000000000000000000000000f1b77573a8525acfa116a785092d1ba90d96bf37")
+	to := ethcommon.HexToHash("0x0000000000000000000000005d95ae932d42e53bb9da4de65e9b7263a4fa8564")
+	zero := ethcommon.Hash{}
+
+	metadata := func(tokenID int64, amount int64) map[string]interface{} {
+		return map[string]interface{}{
+			ContractAddressMetadata:   "0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7",
+			IndexTransferredMetadata:  ethcommon.BigToHash(big.NewInt(tokenID)).String(),
+			AmountTransferredMetadata: big.NewInt(amount).String(),
+		}
+	}
+
+	t.Run("event signatures", func(t *testing.T) {
+		assert.Equal(t, transferSingleMethodHash,
+			ethcrypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)")).String())
+		assert.Equal(t, transferBatchMethodHash,
+			ethcrypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])")).String())
+	})
+
+	t.Run("transfer single op", func(t *testing.T) {
+		data := append(ethcommon.BigToHash(big.NewInt(81)).Bytes(), ethcommon.BigToHash(big.NewInt(5)).Bytes()...)
+		log := &ethtypes.Log{
+			Address: contract,
+			Topics:  []ethcommon.Hash{ethcommon.HexToHash(transferSingleMethodHash), operator, from, to},
+			Data:    data,
+		}
+
+		ops, err := erc1155Ops(log, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 1,
+				},
+				Type:   OpErc1155TransferSender,
+				Status: types.String(StatusSuccess),
+				Account: &types.AccountIdentifier{
+					Address: "0xf1B77573A8525aCfa116a785092d1Ba90D96BF37",
+				},
+				Metadata: metadata(81, 5),
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 2,
+				},
+				RelatedOperations: []*types.OperationIdentifier{
+					{
+						Index: 1,
+					},
+				},
+				Type:   OpErc1155TransferReceive,
+				Status: types.String(StatusSuccess),
+				Account: &types.AccountIdentifier{
+					Address: "0x5d95ae932D42E53Bb9DA4DE65E9b7263A4fA8564",
+				},
+				Metadata: metadata(81, 5),
+			},
+		}, ops)
+	})
+
+	t.Run("transfer batch mint op", func(t *testing.T) {
+		data, err := erc1155BatchTransferArgs.Pack(
+			[]*big.Int{big.NewInt(1), big.NewInt(2)},
+			[]*big.Int{big.NewInt(10), big.NewInt(20)},
+		)
+		assert.Nil(t, err)
+		log := &ethtypes.Log{
+			Address: contract,
+			Topics:  []ethcommon.Hash{ethcommon.HexToHash(transferBatchMethodHash), operator, zero, to},
+			Data:    data,
+		}
+
+		ops, err := erc1155Ops(log, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 1,
+				},
+				Type:   OpErc1155Mint,
+				Status: types.String(StatusSuccess),
+				Account: &types.AccountIdentifier{
+					Address: "0x5d95ae932D42E53Bb9DA4DE65E9b7263A4fA8564",
+				},
+				Metadata: metadata(1, 10),
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 2,
+				},
+				Type:   OpErc1155Mint,
+				Status: types.String(StatusSuccess),
+				Account: &types.AccountIdentifier{
+					Address: "0x5d95ae932D42E53Bb9DA4DE65E9b7263A4fA8564",
+				},
+				Metadata: metadata(2, 20),
+			},
+		}, ops)
+	})
+
+	t.Run("transfer batch burn op", func(t *testing.T) {
+		data, err := erc1155BatchTransferArgs.Pack([]*big.Int{big.NewInt(3)}, []*big.Int{big.NewInt(30)})
+		assert.Nil(t, err)
+		log := &ethtypes.Log{
+			Address: contract,
+			Topics:  []ethcommon.Hash{ethcommon.HexToHash(transferBatchMethodHash), operator, from, zero},
+			Data:    data,
+		}
+
+		ops, err := erc1155Ops(log, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 1,
+				},
+				Type:   OpErc1155Burn,
+				Status: types.String(StatusSuccess),
+				Account: &types.AccountIdentifier{
+					Address: "0xf1B77573A8525aCfa116a785092d1Ba90D96BF37",
+				},
+				Metadata: metadata(3, 30),
+			},
+		}, ops)
+	})
+
+	t.Run("malformed data is rejected", func(t *testing.T) {
+		log := &ethtypes.Log{
+			Address: contract,
+			Topics:  []ethcommon.Hash{ethcommon.HexToHash(transferSingleMethodHash), operator, from, to},
+			Data:    []byte{1},
+		}
+
+		_, err := erc1155Ops(log, 1)
+		assert.ErrorIs(t, err, errInvalidErc1155Transfer)
+	})
+}
+
 func TestCrossChainExportedOuts(t *testing.T) {
 	t.Run("Cross chain exported outputs in metadata", func(t *testing.T) {
 		var (
@@ -16,15 +16,24 @@ const (
 	FujiAssetID = "U8iRqJoiJm8xZHAacmvYyZVwqQx6uDNtQeP3CQ6fcgQk3JqnK"
 	FujiNetwork = "Fuji"
 
+	MainnetWAVAXAddress = "0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7"
+	FujiWAVAXAddress    = "0xd00ae08403B9bbb9124bB305C09058E32C39A48c"
+
 	PChainNetworkIdentifier = "P"
 	CChainNetworkIdentifier = "C"
 	XChainNetworkIdentifier = "X"
 
-	ContractAddressMetadata  = "contractAddress"
-	IndexTransferredMetadata = "indexTransferred"
+	ContractAddressMetadata   = "contractAddress"
+	IndexTransferredMetadata  = "indexTransferred"
+	AmountTransferredMetadata = "amountTransferred"
+	CallDepthMetadata         = "callDepth"
+	InputSelectorMetadata     = "inputSelector"
+	GasUsedMetadata           = "gasUsed"
+	RevertReasonMetadata      = "revertReason"
 
 	OpCall          = "CALL"
 	OpFee           = "FEE"
+	OpFeeBurn       = "FEE_BURN"
 	OpCreate        = "CREATE"
 	OpCreate2       = "CREATE2"
 	OpSelfDestruct  = "SELFDESTRUCT"
@@ -43,6 +52,19 @@ const (
 	OpErc721Mint            = "ERC721_MINT"
 	OpErc721Burn            = "ERC721_BURN"
 
+	OpErc1155TransferSender  = "ERC1155_SENDER"
+	OpErc1155TransferReceive = "ERC1155_RECEIVE"
+	OpErc1155Mint            = "ERC1155_MINT"
+	OpErc1155Burn            = "ERC1155_BURN"
+
+	// Verbosity levels of the C-Chain transaction metadata in /block responses
+	MetadataVerbosityNone    = "none"
+	MetadataVerbositySummary = "summary"
+	MetadataVerbosityFull    = "full"
+
+	// CallGetTransactionMetadata returns the receipt and trace of a transaction
+	CallGetTransactionMetadata = "eth_getTransactionMetadata"
+
 	StatusSuccess = "SUCCESS"
 	StatusFailure = "FAILURE"
 
@@ -89,6 +111,7 @@ var (
 
 	OperationTypes = []string{
 		OpFee,
+		OpFeeBurn,
 		OpCall,
 		OpCreate,
 		OpCreate2,
@@ -106,10 +129,15 @@ var (
 		OpErc721TransferSender,
 		OpErc721Mint,
 		OpErc721Burn,
+		OpErc1155TransferReceive,
+		OpErc1155TransferSender,
+		OpErc1155Mint,
+		OpErc1155Burn,
 	}
 
 	CallMethods = []string{
 		"eth_getTransactionReceipt",
+		CallGetTransactionMetadata,
 	}
 )
 
@@ -0,0 +1,55 @@
+package xchain
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+)
+
+var errInvalidAssetID = errors.New("invalid asset id in currency metadata")
+
+// IsXChain checks network identifier to make sure sub-network identifier set to "X"
+func IsXChain(networkIdentifier *types.NetworkIdentifier) bool {
+	return networkIdentifier != nil &&
+		networkIdentifier.SubNetworkIdentifier != nil &&
+		networkIdentifier.SubNetworkIdentifier.Network == mapper.XChainNetworkIdentifier
+}
+
+// Currency returns the currency of an X-chain asset.
+//
+// AVAX is represented the same way as on the P-chain. Symbols of other assets
+// are not unique, therefore their currencies carry the asset id as metadata.
+func Currency(avaxAssetID ids.ID, assetID ids.ID, symbol string, denomination uint8) *types.Currency {
+	if assetID == avaxAssetID {
+		return mapper.AtomicAvaxCurrency
+	}
+
+	return &types.Currency{
+		Symbol:   symbol,
+		Decimals: int32(denomination),
+		Metadata: map[string]interface{}{
+			MetadataAssetID: assetID.String(),
+		},
+	}
+}
+
+// CurrencyAssetID returns the asset id of a currency returned by [Currency]
+func CurrencyAssetID(avaxAssetID ids.ID, currency *types.Currency) (ids.ID, error) {
+	assetID, ok := currency.Metadata[MetadataAssetID]
+	if !ok {
+		if currency.Symbol == mapper.AtomicAvaxCurrency.Symbol && currency.Decimals == mapper.AtomicAvaxCurrency.Decimals {
+			return avaxAssetID, nil
+		}
+		return ids.Empty, errInvalidAssetID
+	}
+
+	assetIDStr, ok := assetID.(string)
+	if !ok {
+		return ids.Empty, errInvalidAssetID
+	}
+
+	return ids.FromString(assetIDStr)
+}
@@ -0,0 +1,290 @@
+package xchain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/parser"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+)
+
+var errInvalidMetadata = errors.New("invalid metadata")
+
+// BuildTx builds an unsigned X-chain tx of [opType] from matched operations.
+//
+// The returned signers are ordered like the credentials of the tx, that is
+// the sorted inputs followed by the sorted imported inputs.
+func BuildTx(
+	opType string,
+	matches []*parser.Match,
+	payloadMetadata pmapper.Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	switch opType {
+	case OpBase:
+		return buildBaseTx(matches, payloadMetadata, codec, avaxAssetID)
+	case OpImportAvax:
+		return buildImportTx(matches, payloadMetadata, codec, avaxAssetID)
+	case OpExportAvax:
+		return buildExportTx(matches, payloadMetadata, codec, avaxAssetID)
+	default:
+		return nil, nil, fmt.Errorf("invalid tx type: %s", opType)
+	}
+}
+
+func buildBaseTx(
+	matches []*parser.Match,
+	metadata pmapper.Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	ins, _, signers, err := buildInputs(matches[0].Operations, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse inputs failed: %w", err)
+	}
+
+	outs, _, err := buildOutputs(matches[1].Operations, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse outputs failed: %w", err)
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    metadata.NetworkID,
+		BlockchainID: metadata.BlockchainID,
+		Outs:         outs,
+		Ins:          ins,
+	}}}
+
+	return tx, signers, nil
+}
+
+func buildImportTx(
+	matches []*parser.Match,
+	metadata pmapper.Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	if metadata.ImportMetadata == nil {
+		return nil, nil, errInvalidMetadata
+	}
+
+	ins, imported, signers, err := buildInputs(matches[0].Operations, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse inputs failed: %w", err)
+	}
+
+	outs, _, err := buildOutputs(matches[1].Operations, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse outputs failed: %w", err)
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.ImportTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    metadata.NetworkID,
+			BlockchainID: metadata.BlockchainID,
+			Outs:         outs,
+			Ins:          ins,
+		}},
+		SourceChain: metadata.SourceChainID,
+		ImportedIns: imported,
+	}}
+
+	return tx, signers, nil
+}
+
+func buildExportTx(
+	matches []*parser.Match,
+	metadata pmapper.Metadata,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (*txs.Tx, []*types.AccountIdentifier, error) {
+	if metadata.ExportMetadata == nil {
+		return nil, nil, errInvalidMetadata
+	}
+
+	ins, _, signers, err := buildInputs(matches[0].Operations, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse inputs failed: %w", err)
+	}
+
+	outs, exported, err := buildOutputs(matches[1].Operations, codec, avaxAssetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse outputs failed: %w", err)
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.ExportTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    metadata.NetworkID,
+			BlockchainID: metadata.BlockchainID,
+			Outs:         outs,
+			Ins:          ins,
+		}},
+		DestinationChain: metadata.DestinationChainID,
+		ExportedOuts:     exported,
+	}}
+
+	return tx, signers, nil
+}
+
+type signedInput struct {
+	in     *avax.TransferableInput
+	signer *types.AccountIdentifier
+}
+
+func buildInputs(
+	operations []*types.Operation,
+	avaxAssetID ids.ID,
+) (
+	ins []*avax.TransferableInput,
+	imported []*avax.TransferableInput,
+	signers []*types.AccountIdentifier,
+	err error,
+) {
+	var signedIns, signedImported []signedInput
+	for _, op := range operations {
+		UTXOID, err := mapper.DecodeUTXOID(op.CoinChange.CoinIdentifier.Identifier)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to decode UTXO ID: %w", err)
+		}
+
+		opMetadata, err := pmapper.ParseOpMetadata(op.Metadata)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("parse input operation Metadata failed: %w", err)
+		}
+
+		val, err := types.AmountValue(op.Amount)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("parse operation amount failed: %w", err)
+		}
+
+		assetID, err := CurrencyAssetID(avaxAssetID, op.Amount.Currency)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		in := signedInput{
+			in: &avax.TransferableInput{
+				UTXOID: *UTXOID,
+				Asset:  avax.Asset{ID: assetID},
+				In: &secp256k1fx.TransferInput{
+					Amt: new(big.Int).Abs(val).Uint64(),
+					Input: secp256k1fx.Input{
+						SigIndices: opMetadata.SigIndices,
+					},
+				},
+			},
+			signer: op.Account,
+		}
+
+		switch opMetadata.Type {
+		case pmapper.OpTypeImport:
+			signedImported = append(signedImported, in)
+		case pmapper.OpTypeInput:
+			signedIns = append(signedIns, in)
+		default:
+			return nil, nil, nil, fmt.Errorf("invalid option type: %s", op.Type)
+		}
+	}
+
+	// Inputs are sorted together with their signers so that the signatures
+	// can be matched with the credentials of the inputs
+	sortSignedInputs(signedIns)
+	sortSignedInputs(signedImported)
+
+	for _, in := range signedIns {
+		ins = append(ins, in.in)
+		signers = append(signers, in.signer)
+	}
+	for _, in := range signedImported {
+		imported = append(imported, in.in)
+		signers = append(signers, in.signer)
+	}
+
+	return ins, imported, signers, nil
+}
+
+// sortSignedInputs sorts inputs the same way as avax.SortTransferableInputs
+func sortSignedInputs(ins []signedInput) {
+	sort.SliceStable(ins, func(i, j int) bool {
+		iID, jID := ins[i].in.UTXOID, ins[j].in.UTXOID
+		switch bytes.Compare(iID.TxID[:], jID.TxID[:]) {
+		case -1:
+			return true
+		case 0:
+			return iID.OutputIndex < jID.OutputIndex
+		default:
+			return false
+		}
+	})
+}
+
+func buildOutputs(
+	operations []*types.Operation,
+	codec codec.Manager,
+	avaxAssetID ids.ID,
+) (
+	outs []*avax.TransferableOutput,
+	exported []*avax.TransferableOutput,
+	err error,
+) {
+	for _, op := range operations {
+		opMetadata, err := pmapper.ParseOpMetadata(op.Metadata)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse output operation Metadata failed: %w", err)
+		}
+
+		addrID, err := address.ParseToID(op.Account.Address)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse output address failed: %w", err)
+		}
+
+		val, err := types.AmountValue(op.Amount)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse operation amount failed: %w", err)
+		}
+
+		assetID, err := CurrencyAssetID(avaxAssetID, op.Amount.Currency)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		out := &avax.TransferableOutput{
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: val.Uint64(),
+				OutputOwners: secp256k1fx.OutputOwners{
+					Addrs:     []ids.ShortID{addrID},
+					Locktime:  opMetadata.Locktime,
+					Threshold: opMetadata.Threshold,
+				},
+			},
+		}
+
+		switch opMetadata.Type {
+		case pmapper.OpTypeOutput:
+			outs = append(outs, out)
+		case pmapper.OpTypeExport:
+			exported = append(exported, out)
+		default:
+			return nil, nil, fmt.Errorf("invalid option type: %s", op.Type)
+		}
+	}
+
+	avax.SortTransferableOutputs(outs, codec)
+	avax.SortTransferableOutputs(exported, codec)
+
+	return outs, exported, nil
+}
@@ -0,0 +1,462 @@
+package xchain
+
+import (
+	"errors"
+	"log"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+)
+
+var (
+	errUnknownDestinationChain  = errors.New("unknown destination chain")
+	errUnknownAsset             = errors.New("unknown asset")
+	errNoMatchingInputAddresses = errors.New("no matching input addresses")
+	errFailedToGetUTXOAddresses = errors.New("failed to get utxo addresses")
+	errFailedToCheckMultisig    = errors.New("failed to check utxo for multisig")
+	errInputTypeAssertion       = errors.New("input type assertion failed")
+)
+
+type TxParser struct {
+	isConstruction  bool
+	hrp             string
+	chainIDs        map[string]string
+	currencies      map[ids.ID]*types.Currency
+	inputTxAccounts map[string]*types.AccountIdentifier
+	dependencyTxs   map[string]*txs.Tx
+}
+
+// NewTxParser returns a parser of X-chain transactions.
+//
+// [currencies] must contain the currency of every asset consumed or produced by parsed transactions.
+// [dependencyTxs] are the transactions producing the inputs of parsed transactions. They are used to
+// skip multisig inputs and can be nil during construction.
+func NewTxParser(
+	isConstruction bool,
+	hrp string,
+	chainIDs map[string]string,
+	currencies map[ids.ID]*types.Currency,
+	inputTxAccounts map[string]*types.AccountIdentifier,
+	dependencyTxs map[string]*txs.Tx,
+) *TxParser {
+	if inputTxAccounts == nil {
+		inputTxAccounts = make(map[string]*types.AccountIdentifier)
+	}
+
+	return &TxParser{
+		isConstruction:  isConstruction,
+		hrp:             hrp,
+		chainIDs:        chainIDs,
+		currencies:      currencies,
+		inputTxAccounts: inputTxAccounts,
+		dependencyTxs:   dependencyTxs,
+	}
+}
+
+func (t *TxParser) Parse(tx *txs.Tx) (*types.Transaction, error) {
+	var txType string
+	var exportedOuts []*avax.TransferableOutput
+	var exportChainID ids.ID
+
+	switch unsignedTx := tx.Unsigned.(type) {
+	case *txs.BaseTx:
+		txType = OpBase
+	case *txs.ImportTx:
+		txType = OpImportAvax
+	case *txs.ExportTx:
+		txType = OpExportAvax
+		exportedOuts = unsignedTx.ExportedOuts
+		exportChainID = unsignedTx.DestinationChain
+	case *txs.CreateAssetTx:
+		txType = OpCreateAsset
+	case *txs.OperationTx:
+		txType = OpOperation
+	}
+	ins, importedIns := getTxInputs(tx.Unsigned)
+
+	ops := newTxOps(t.isConstruction)
+
+	err := t.insToOperations(ops, txType, ins, pmapper.OpTypeInput)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.insToOperations(ops, txType, importedIns, pmapper.OpTypeImport)
+	if err != nil {
+		return nil, err
+	}
+
+	txID := tx.ID()
+	utxos := tx.UTXOs()
+	err = t.utxosToOperations(ops, txType, utxos, pmapper.OpTypeOutput, mapper.XChainNetworkIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if exportedOuts != nil {
+		chainIDAlias, ok := t.chainIDs[exportChainID.String()]
+		if !ok {
+			return nil, errUnknownDestinationChain
+		}
+
+		// Exported UTXOs are indexed after the outputs of the tx
+		exportedUTXOs := make([]*avax.UTXO, len(exportedOuts))
+		for i, out := range exportedOuts {
+			exportedUTXOs[i] = &avax.UTXO{
+				UTXOID: avax.UTXOID{
+					TxID:        txID,
+					OutputIndex: uint32(len(utxos) + i),
+				},
+				Asset: out.Asset,
+				Out:   out.Out,
+			}
+		}
+
+		err = t.utxosToOperations(ops, txType, exportedUTXOs, pmapper.OpTypeExport, chainIDAlias)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txMetadata := map[string]interface{}{
+		pmapper.MetadataTxType: txType,
+	}
+
+	if ops.ImportIns != nil {
+		txMetadata[mapper.MetadataImportedInputs] = ops.ImportIns
+	}
+
+	if ops.ExportOuts != nil {
+		txMetadata[mapper.MetadataExportedOutputs] = ops.ExportOuts
+	}
+
+	return &types.Transaction{
+		TransactionIdentifier: &types.TransactionIdentifier{
+			Hash: txID.String(),
+		},
+		Operations: ops.IncludedOperations(),
+		Metadata:   txMetadata,
+	}, nil
+}
+
+func (t *TxParser) insToOperations(
+	inOps *txOps,
+	opType string,
+	txIns []*avax.TransferableInput,
+	metaType string,
+) error {
+	status := types.String(mapper.StatusSuccess)
+	if t.isConstruction {
+		status = nil
+	}
+
+	for _, in := range txIns {
+		transferInput, ok := in.In.(*secp256k1fx.TransferInput)
+		if !ok {
+			return errInputTypeAssertion
+		}
+
+		currency, ok := t.currencies[in.AssetID()]
+		if !ok {
+			return errUnknownAsset
+		}
+
+		metadata := &pmapper.OperationMetadata{
+			Type:       metaType,
+			SigIndices: transferInput.SigIndices,
+		}
+
+		opMetadata, err := mapper.MarshalJSONMap(metadata)
+		if err != nil {
+			return err
+		}
+
+		utxoID := in.UTXOID.String()
+
+		var account *types.AccountIdentifier
+
+		// Imported inputs are not part of the X-chain state, therefore their
+		// accounts are only known when the tx is being constructed
+		if t.isConstruction || metaType != pmapper.OpTypeImport {
+			if t.dependencyTxs != nil {
+				isMultisig, err := t.isMultisig(in.UTXOID)
+				if err != nil {
+					return errFailedToCheckMultisig
+				}
+				if isMultisig {
+					continue
+				}
+			}
+
+			account, ok = t.inputTxAccounts[utxoID]
+			if !ok {
+				return errNoMatchingInputAddresses
+			}
+		}
+
+		inputAmount := new(big.Int).SetUint64(transferInput.Amount())
+		inOp := &types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: int64(inOps.Len()),
+			},
+			Type:    opType,
+			Status:  status,
+			Account: account,
+			// Negating input amount
+			Amount: mapper.Amount(new(big.Int).Neg(inputAmount), currency),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{
+					Identifier: utxoID,
+				},
+				CoinAction: types.CoinSpent,
+			},
+			Metadata: opMetadata,
+		}
+
+		inOps.Append(inOp, metaType)
+	}
+
+	return nil
+}
+
+func (t *TxParser) utxosToOperations(
+	outOps *txOps,
+	opType string,
+	utxos []*avax.UTXO,
+	metaType string,
+	chainIDAlias string,
+) error {
+	status := types.String(mapper.StatusSuccess)
+	if t.isConstruction {
+		status = nil
+	}
+
+	for _, utxo := range utxos {
+		// Mint, NFT and property outputs carry no fungible amount
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+
+		// Rosetta cannot handle multisig at the moment. In order to pass data validation,
+		// we treat multisig outputs like a burn and inputs line a mint and therefore
+		// not include them in the operations
+		if len(out.Addrs) != 1 {
+			continue
+		}
+
+		currency, ok := t.currencies[utxo.AssetID()]
+		if !ok {
+			return errUnknownAsset
+		}
+
+		outAddrFormat, err := address.Format(chainIDAlias, t.hrp, out.Addrs[0][:])
+		if err != nil {
+			return err
+		}
+
+		metadata := &pmapper.OperationMetadata{
+			Type:      metaType,
+			Threshold: out.OutputOwners.Threshold,
+			Locktime:  out.OutputOwners.Locktime,
+		}
+
+		opMetadata, err := mapper.MarshalJSONMap(metadata)
+		if err != nil {
+			return err
+		}
+
+		// Do not add coin change during construction as txid is not yet generated
+		// and therefore UTXO ids would be incorrect
+		var coinChange *types.CoinChange
+		if !t.isConstruction {
+			coinChange = &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: utxo.UTXOID.String()},
+				CoinAction:     types.CoinCreated,
+			}
+		}
+
+		outOp := &types.Operation{
+			Type: opType,
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: int64(outOps.Len()),
+			},
+			CoinChange: coinChange,
+			Status:     status,
+			Account:    &types.AccountIdentifier{Address: outAddrFormat},
+			Amount:     mapper.Amount(new(big.Int).SetUint64(out.Amount()), currency),
+			Metadata:   opMetadata,
+		}
+
+		outOps.Append(outOp, metaType)
+	}
+
+	return nil
+}
+
+func (t *TxParser) isMultisig(utxoID avax.UTXOID) (bool, error) {
+	dependencyTx, ok := t.dependencyTxs[utxoID.TxID.String()]
+	if !ok {
+		return false, errFailedToCheckMultisig
+	}
+
+	for _, utxo := range dependencyTx.UTXOs() {
+		if utxo.OutputIndex != utxoID.OutputIndex {
+			continue
+		}
+
+		addressable, ok := utxo.Out.(avax.Addressable)
+		if !ok {
+			return false, errFailedToCheckMultisig
+		}
+
+		return len(addressable.Addresses()) != 1, nil
+	}
+
+	return false, errFailedToCheckMultisig
+}
+
+// GetAccountsFromUTXOs maps the single address UTXOs produced by [dependencyTxs] to their owners
+func GetAccountsFromUTXOs(hrp string, dependencyTxs map[string]*txs.Tx) (map[string]*types.AccountIdentifier, error) {
+	addresses := make(map[string]*types.AccountIdentifier)
+	for _, dependencyTx := range dependencyTxs {
+		for _, utxo := range dependencyTx.UTXOs() {
+			addressable, ok := utxo.Out.(avax.Addressable)
+			if !ok {
+				return nil, errFailedToGetUTXOAddresses
+			}
+
+			addrs := addressable.Addresses()
+			if len(addrs) != 1 {
+				continue
+			}
+
+			addr, err := address.Format(mapper.XChainNetworkIdentifier, hrp, addrs[0])
+			if err != nil {
+				return nil, err
+			}
+			addresses[utxo.UTXOID.String()] = &types.AccountIdentifier{Address: addr}
+		}
+	}
+
+	return addresses, nil
+}
+
+// GetDependencyTxIDs returns the sorted ids of the X-chain txs producing the inputs of [tx]
+func GetDependencyTxIDs(tx txs.UnsignedTx) []ids.ID {
+	ins, _ := getTxInputs(tx)
+
+	txIDSet := ids.NewSet(len(ins))
+	for _, in := range ins {
+		txIDSet.Add(in.TxID)
+	}
+
+	txIDs := txIDSet.List()
+	ids.SortIDs(txIDs)
+	return txIDs
+}
+
+// GetAssetIDs returns the sorted ids of all fungible assets consumed or produced by [tx]
+func GetAssetIDs(tx *txs.Tx) []ids.ID {
+	assetIDSet := ids.NewSet(0)
+
+	ins, importedIns := getTxInputs(tx.Unsigned)
+	for _, in := range append(ins, importedIns...) {
+		assetIDSet.Add(in.AssetID())
+	}
+
+	if exportTx, ok := tx.Unsigned.(*txs.ExportTx); ok {
+		for _, out := range exportTx.ExportedOuts {
+			assetIDSet.Add(out.AssetID())
+		}
+	}
+
+	for _, utxo := range tx.UTXOs() {
+		if _, ok := utxo.Out.(*secp256k1fx.TransferOutput); ok {
+			assetIDSet.Add(utxo.AssetID())
+		}
+	}
+
+	assetIDs := assetIDSet.List()
+	ids.SortIDs(assetIDs)
+	return assetIDs
+}
+
+// getTxInputs returns the inputs and the imported inputs of [tx].
+//
+// Operations of OperationTxs consume mint and NFT outputs which carry no amount,
+// therefore only the regular inputs are returned for them.
+func getTxInputs(tx txs.UnsignedTx) ([]*avax.TransferableInput, []*avax.TransferableInput) {
+	switch unsignedTx := tx.(type) {
+	case *txs.BaseTx:
+		return unsignedTx.Ins, nil
+	case *txs.ImportTx:
+		return unsignedTx.Ins, unsignedTx.ImportedIns
+	case *txs.ExportTx:
+		return unsignedTx.Ins, nil
+	case *txs.CreateAssetTx:
+		return unsignedTx.Ins, nil
+	case *txs.OperationTx:
+		return unsignedTx.Ins, nil
+	default:
+		log.Printf("unknown type %T", unsignedTx)
+		return nil, nil
+	}
+}
+
+type txOps struct {
+	isConstruction bool
+	Ins            []*types.Operation
+	Outs           []*types.Operation
+	ImportIns      []*types.Operation
+	ExportOuts     []*types.Operation
+}
+
+func newTxOps(isConstruction bool) *txOps {
+	return &txOps{isConstruction: isConstruction}
+}
+
+func (t *txOps) IncludedOperations() []*types.Operation {
+	ops := []*types.Operation{}
+	ops = append(ops, t.Ins...)
+	ops = append(ops, t.Outs...)
+	return ops
+}
+
+// Used to populate operation identifier
+func (t *txOps) Len() int {
+	return len(t.Ins) + len(t.Outs)
+}
+
+func (t *txOps) Append(op *types.Operation, metaType string) {
+	switch metaType {
+	case pmapper.OpTypeImport:
+		if t.isConstruction {
+			t.Ins = append(t.Ins, op)
+		} else {
+			// removing operation identifier as these will be skipped in the final operations list
+			op.OperationIdentifier = nil
+			t.ImportIns = append(t.ImportIns, op)
+		}
+	case pmapper.OpTypeExport:
+		if t.isConstruction {
+			t.Outs = append(t.Outs, op)
+		} else {
+			// removing operation identifier as these will be skipped in the final operations list
+			op.OperationIdentifier = nil
+			t.ExportOuts = append(t.ExportOuts, op)
+		}
+	case pmapper.OpTypeOutput:
+		t.Outs = append(t.Outs, op)
+	case pmapper.OpTypeInput:
+		t.Ins = append(t.Ins, op)
+	}
+}
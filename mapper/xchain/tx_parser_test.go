@@ -0,0 +1,147 @@
+package xchain
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+)
+
+var (
+	avaxAssetID  = ids.ID{'a', 'v', 'a', 'x'}
+	tokenAssetID = ids.ID{'t', 'k', 'n'}
+)
+
+func newParser(t *testing.T) txs.Parser {
+	parser, err := txs.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+		&nftfx.Fx{},
+		&propertyfx.Fx{},
+	})
+	assert.Nil(t, err)
+	return parser
+}
+
+func TestCurrency(t *testing.T) {
+	assert.Equal(t, mapper.AtomicAvaxCurrency, Currency(avaxAssetID, avaxAssetID, "", 0))
+
+	tokenCurrency := Currency(avaxAssetID, tokenAssetID, "TKN", 2)
+	assert.Equal(t, &types.Currency{
+		Symbol:   "TKN",
+		Decimals: 2,
+		Metadata: map[string]interface{}{MetadataAssetID: tokenAssetID.String()},
+	}, tokenCurrency)
+
+	assetID, err := CurrencyAssetID(avaxAssetID, mapper.AtomicAvaxCurrency)
+	assert.Nil(t, err)
+	assert.Equal(t, avaxAssetID, assetID)
+
+	assetID, err = CurrencyAssetID(avaxAssetID, tokenCurrency)
+	assert.Nil(t, err)
+	assert.Equal(t, tokenAssetID, assetID)
+
+	_, err = CurrencyAssetID(avaxAssetID, &types.Currency{Symbol: "TKN", Decimals: 2})
+	assert.Equal(t, errInvalidAssetID, err)
+}
+
+func TestParseOperationTx(t *testing.T) {
+	parser := newParser(t)
+
+	addr := ids.ShortID{1}
+	otherAddr := ids.ShortID{2}
+	owners := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}}
+	multisigOwners := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr, otherAddr}}
+
+	dependencyTx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{
+			{
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out:   &secp256k1fx.TransferOutput{Amt: 1_000, OutputOwners: owners},
+			},
+			{
+				Asset: avax.Asset{ID: tokenAssetID},
+				Out:   &secp256k1fx.TransferOutput{Amt: 10, OutputOwners: multisigOwners},
+			},
+		},
+	}}}
+	assert.Nil(t, parser.InitializeTx(dependencyTx))
+
+	tx := &txs.Tx{Unsigned: &txs.OperationTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			Ins: []*avax.TransferableInput{
+				{
+					UTXOID: avax.UTXOID{TxID: dependencyTx.ID(), OutputIndex: 0},
+					Asset:  avax.Asset{ID: avaxAssetID},
+					In:     &secp256k1fx.TransferInput{Amt: 1_000, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+				},
+				{
+					UTXOID: avax.UTXOID{TxID: dependencyTx.ID(), OutputIndex: 1},
+					Asset:  avax.Asset{ID: tokenAssetID},
+					In:     &secp256k1fx.TransferInput{Amt: 10, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+				},
+			},
+			Outs: []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out:   &secp256k1fx.TransferOutput{Amt: 900, OutputOwners: owners},
+			}},
+		}},
+		Ops: []*txs.Operation{{
+			Asset:   avax.Asset{ID: tokenAssetID},
+			UTXOIDs: []*avax.UTXOID{{TxID: ids.ID{3}}},
+			Op: &nftfx.TransferOperation{
+				Input:  secp256k1fx.Input{SigIndices: []uint32{0}},
+				Output: nftfx.TransferOutput{OutputOwners: owners},
+			},
+		}},
+	}}
+	assert.Nil(t, parser.InitializeTx(tx))
+
+	dependencyTxs := map[string]*txs.Tx{dependencyTx.ID().String(): dependencyTx}
+	assert.Equal(t, []ids.ID{dependencyTx.ID()}, GetDependencyTxIDs(tx.Unsigned))
+	assert.ElementsMatch(t, []ids.ID{avaxAssetID, tokenAssetID}, GetAssetIDs(tx))
+
+	inputAccounts, err := GetAccountsFromUTXOs("fuji", dependencyTxs)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(inputAccounts))
+
+	currencies := map[ids.ID]*types.Currency{
+		avaxAssetID:  mapper.AtomicAvaxCurrency,
+		tokenAssetID: Currency(avaxAssetID, tokenAssetID, "TKN", 2),
+	}
+	txParser := NewTxParser(false, "fuji", nil, currencies, inputAccounts, dependencyTxs)
+	transaction, err := txParser.Parse(tx)
+	assert.Nil(t, err)
+
+	// The multisig input and the NFT output are skipped
+	assert.Equal(t, OpOperation, transaction.Metadata[pmapper.MetadataTxType])
+	assert.Equal(t, 2, len(transaction.Operations))
+
+	in := transaction.Operations[0]
+	assert.Equal(t, "-1000", in.Amount.Value)
+	assert.Equal(t, types.CoinSpent, in.CoinChange.CoinAction)
+	assert.Equal(t, inputAccounts[tx.Unsigned.(*txs.OperationTx).Ins[0].UTXOID.String()], in.Account)
+	assert.Equal(t, pmapper.OpTypeInput, in.Metadata["type"])
+
+	out := transaction.Operations[1]
+	assert.Equal(t, int64(1), out.OperationIdentifier.Index)
+	assert.Equal(t, "900", out.Amount.Value)
+	assert.Equal(t, mapper.AtomicAvaxCurrency, out.Amount.Currency)
+	assert.Equal(t, types.CoinCreated, out.CoinChange.CoinAction)
+	assert.Equal(t, tx.ID().String()+":0", out.CoinChange.CoinIdentifier.Identifier)
+
+	t.Run("unknown assets are rejected", func(t *testing.T) {
+		txParser := NewTxParser(false, "fuji", nil, map[ids.ID]*types.Currency{}, inputAccounts, dependencyTxs)
+		_, err := txParser.Parse(tx)
+		assert.Equal(t, errUnknownAsset, err)
+	})
+}
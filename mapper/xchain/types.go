@@ -0,0 +1,22 @@
+package xchain
+
+const (
+	OpBase        = "BASE"
+	OpImportAvax  = "IMPORT_AVAX"
+	OpExportAvax  = "EXPORT_AVAX"
+	OpCreateAsset = "CREATE_ASSET"
+	OpOperation   = "OPERATION"
+
+	MetadataAssetID = "asset_id"
+)
+
+var (
+	OperationTypes = []string{
+		OpBase,
+		OpImportAvax,
+		OpExportAvax,
+		OpCreateAsset,
+		OpOperation,
+	}
+	CallMethods = []string{}
+)
@@ -0,0 +1,259 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+const (
+	namespace = "rosetta"
+
+	// unknownEndpoint is used as endpoint label for requests to paths that are not served
+	unknownEndpoint = "unknown"
+)
+
+// Interface compliance
+var (
+	_ client.CallObserver       = &Metrics{}
+	_ client.ResilienceObserver = &Metrics{}
+)
+
+// Metrics holds the Prometheus metrics of the Rosetta server
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests             *prometheus.CounterVec
+	requestDuration      *prometheus.HistogramVec
+	errors               *prometheus.CounterVec
+	upstreamDuration     *prometheus.HistogramVec
+	upstreamFailures     *prometheus.CounterVec
+	upstreamRetries      *prometheus.CounterVec
+	circuitOpen          *prometheus.GaugeVec
+	chainHeight          *prometheus.GaugeVec
+	heightUpdateInterval time.Duration
+}
+
+// New returns a new set of metrics, registered in their own registry
+func New() (*Metrics, error) {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of requests by endpoint and HTTP status code",
+		}, []string{"endpoint", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of requests by endpoint",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "errors_total",
+			Help:      "Number of Rosetta errors returned by endpoint and error code",
+		}, []string{"endpoint", "code"}),
+		upstreamDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "upstream_call_duration_seconds",
+			Help:      "Latency of calls to the avalanche node by client and method",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"client", "method"}),
+		upstreamFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_call_failures_total",
+			Help:      "Number of failed calls to the avalanche node by client and method",
+		}, []string{"client", "method"}),
+		upstreamRetries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_call_retries_total",
+			Help:      "Number of retried calls to the avalanche node by client and method",
+		}, []string{"client", "method"}),
+		circuitOpen: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "upstream_circuit_open",
+			Help:      "Whether calls to the avalanche node are rejected by the circuit breaker, by client",
+		}, []string{"client"}),
+		chainHeight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "chain_height",
+			Help:      "Current height of the chain as reported by the avalanche node",
+		}, []string{"chain"}),
+		heightUpdateInterval: 10 * time.Second,
+	}
+
+	for _, c := range []prometheus.Collector{
+		m.requests,
+		m.requestDuration,
+		m.errors,
+		m.upstreamDuration,
+		m.upstreamFailures,
+		m.upstreamRetries,
+		m.circuitOpen,
+		m.chainHeight,
+	} {
+		if err := m.registry.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Handler returns the handler serving the metrics in the Prometheus format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveCall records the latency and the failure of an upstream call
+func (m *Metrics) ObserveCall(clientName string, method string, duration time.Duration, err error) {
+	m.upstreamDuration.WithLabelValues(clientName, method).Observe(duration.Seconds())
+	if err != nil {
+		m.upstreamFailures.WithLabelValues(clientName, method).Inc()
+	}
+}
+
+// ObserveRetry records the retry of an upstream call
+func (m *Metrics) ObserveRetry(clientName string, method string) {
+	m.upstreamRetries.WithLabelValues(clientName, method).Inc()
+}
+
+// ObserveCircuitOpen records the state of the circuit breaker of [clientName]
+func (m *Metrics) ObserveCircuitOpen(clientName string, open bool) {
+	value := 0.0
+	if open {
+		value = 1
+	}
+	m.circuitOpen.WithLabelValues(clientName).Set(value)
+}
+
+// RegisterContractCache exposes the hits and misses of the contract info cache
+func (m *Metrics) RegisterContractCache(cache client.ContractCache) error {
+	hits := prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "contract_cache_hits_total",
+		Help:      "Number of contract info lookups served from the cache",
+	}, func() float64 {
+		hits, _ := cache.ContractCacheStats()
+		return float64(hits)
+	})
+	misses := prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "contract_cache_misses_total",
+		Help:      "Number of contract info lookups not found in the cache",
+	}, func() float64 {
+		_, misses := cache.ContractCacheStats()
+		return float64(misses)
+	})
+
+	if err := m.registry.Register(hits); err != nil {
+		return err
+	}
+	return m.registry.Register(misses)
+}
+
+// RegisterBlockCache exposes the hits and misses of the block cache of [chain]
+func (m *Metrics) RegisterBlockCache(chain string, cache *service.BlockCache) error {
+	labels := prometheus.Labels{"chain": chain}
+	hits := prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace:   namespace,
+		Name:        "block_cache_hits_total",
+		Help:        "Number of blocks served from the block cache, by chain",
+		ConstLabels: labels,
+	}, func() float64 {
+		hits, _ := cache.BlockCacheStats()
+		return float64(hits)
+	})
+	misses := prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace:   namespace,
+		Name:        "block_cache_misses_total",
+		Help:        "Number of blocks not found in the block cache, by chain",
+		ConstLabels: labels,
+	}, func() float64 {
+		_, misses := cache.BlockCacheStats()
+		return float64(misses)
+	})
+
+	if err := m.registry.Register(hits); err != nil {
+		return err
+	}
+	return m.registry.Register(misses)
+}
+
+// TrackHeight periodically records the height of [chain] returned by [getHeight], until [ctx] is done
+func (m *Metrics) TrackHeight(ctx context.Context, chain string, getHeight func(context.Context) (uint64, error)) {
+	ticker := time.NewTicker(m.heightUpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		height, err := getHeight(ctx)
+		if err != nil {
+			log.Printf("unable to get %s chain height: %v", chain, err)
+		} else {
+			m.chainHeight.WithLabelValues(chain).Set(float64(height))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Middleware records the count, latency and errors of the requests served by [next]
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(rw, r)
+
+		endpoint := r.URL.Path
+		if rw.status == http.StatusNotFound || rw.status == http.StatusMethodNotAllowed {
+			endpoint = unknownEndpoint
+		}
+
+		m.requests.WithLabelValues(endpoint, strconv.Itoa(rw.status)).Inc()
+		m.requestDuration.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+
+		// Rosetta errors are returned as JSON with a non-200 status
+		if rw.status != http.StatusOK && rw.errorBody.Len() > 0 {
+			var rosettaErr types.Error
+			if err := json.Unmarshal(rw.errorBody.Bytes(), &rosettaErr); err == nil {
+				m.errors.WithLabelValues(endpoint, strconv.Itoa(int(rosettaErr.Code))).Inc()
+			}
+		}
+	})
+}
+
+// responseWriter keeps the status code and the body of error responses
+type responseWriter struct {
+	http.ResponseWriter
+	status    int
+	errorBody bytes.Buffer
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if w.status != http.StatusOK {
+		w.errorBody.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+type contractCacheMock struct {
+	hits   uint64
+	misses uint64
+}
+
+func (c *contractCacheMock) ContractCacheStats() (uint64, uint64) {
+	return c.hits, c.misses
+}
+
+func TestMiddleware(t *testing.T) {
+	m, err := New()
+	assert.Nil(t, err)
+
+	handler := m.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/network/list":
+			w.WriteHeader(http.StatusOK)
+		case "/account/balance":
+			w.WriteHeader(http.StatusInternalServerError)
+			assert.Nil(t, json.NewEncoder(w).Encode(&types.Error{Code: 12, Message: "Input is invalid"}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	for _, path := range []string{"/network/list", "/network/list", "/account/balance", "/foo"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, path, nil))
+	}
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.requests.WithLabelValues("/network/list", "200")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.requests.WithLabelValues("/account/balance", "500")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.requests.WithLabelValues(unknownEndpoint, "404")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.errors.WithLabelValues("/account/balance", "12")))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.errors))
+}
+
+func TestObserveCall(t *testing.T) {
+	m, err := New()
+	assert.Nil(t, err)
+
+	m.ObserveCall("P", "GetHeight", time.Millisecond, nil)
+	m.ObserveCall("P", "GetHeight", time.Millisecond, errors.New("connection refused"))
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.upstreamDuration))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.upstreamFailures.WithLabelValues("P", "GetHeight")))
+}
+
+func TestHandler(t *testing.T) {
+	m, err := New()
+	assert.Nil(t, err)
+	assert.Nil(t, m.RegisterContractCache(&contractCacheMock{hits: 3, misses: 1}))
+
+	blockCache := service.NewBlockCache(1)
+	blockCache.Put(&types.Block{BlockIdentifier: &types.BlockIdentifier{Index: 1, Hash: "0x1"}})
+	blockCache.Get(&types.PartialBlockIdentifier{Index: types.Int64(1)})
+	blockCache.Get(&types.PartialBlockIdentifier{Index: types.Int64(2)})
+	blockCache.Get(&types.PartialBlockIdentifier{Hash: types.String("0x2")})
+	assert.Nil(t, m.RegisterBlockCache("P", blockCache))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	m.TrackHeight(ctx, "C", func(context.Context) (uint64, error) {
+		return 42, nil
+	})
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+
+	assert.True(t, strings.Contains(body, "rosetta_contract_cache_hits_total 3"))
+	assert.True(t, strings.Contains(body, "rosetta_contract_cache_misses_total 1"))
+	assert.True(t, strings.Contains(body, `rosetta_chain_height{chain="C"} 42`))
+	assert.True(t, strings.Contains(body, `rosetta_block_cache_hits_total{chain="P"} 1`))
+	assert.True(t, strings.Contains(body, `rosetta_block_cache_misses_total{chain="P"} 2`))
+}
@@ -519,6 +519,29 @@ func (_m *Client) SuggestGasPrice(_a0 context.Context) (*big.Int, error) {
 	return r0, r1
 }
 
+// SuggestGasTipCap provides a mock function with given fields: _a0
+func (_m *Client) SuggestGasTipCap(_a0 context.Context) (*big.Int, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(context.Context) *big.Int); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceBlockByHash provides a mock function with given fields: _a0, _a1
 func (_m *Client) TraceBlockByHash(_a0 context.Context, _a1 string) ([]*client.Call, [][]*client.FlatCall, error) {
 	ret := _m.Called(_a0, _a1)
@@ -636,6 +659,29 @@ func (_m *Client) TransactionReceipt(_a0 context.Context, _a1 common.Hash) (*typ
 	return r0, r1
 }
 
+// TransactionReceipts provides a mock function with given fields: _a0, _a1
+func (_m *Client) TransactionReceipts(_a0 context.Context, _a1 []common.Hash) ([]*types.Receipt, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*types.Receipt
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Hash) []*types.Receipt); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Receipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []common.Hash) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TxPoolContent provides a mock function with given fields: _a0
 func (_m *Client) TxPoolContent(_a0 context.Context) (*client.TxPoolContent, error) {
 	ret := _m.Called(_a0)
@@ -252,6 +252,36 @@ func (_m *PChainClient) GetContainerByIndex(ctx context.Context, index uint64, o
 	return r0, r1
 }
 
+// GetCurrentValidators provides a mock function with given fields: ctx, subnetID, nodeIDs, options
+func (_m *PChainClient) GetCurrentValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]platformvm.ClientPermissionlessValidator, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, subnetID, nodeIDs)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []platformvm.ClientPermissionlessValidator
+	if rf, ok := ret.Get(0).(func(context.Context, ids.ID, []ids.NodeID, ...rpc.Option) []platformvm.ClientPermissionlessValidator); ok {
+		r0 = rf(ctx, subnetID, nodeIDs, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]platformvm.ClientPermissionlessValidator)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ids.ID, []ids.NodeID, ...rpc.Option) error); ok {
+		r1 = rf(ctx, subnetID, nodeIDs, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHeight provides a mock function with given fields: ctx, options
 func (_m *PChainClient) GetHeight(ctx context.Context, options ...rpc.Option) (uint64, error) {
 	_va := make([]interface{}, len(options))
@@ -375,6 +405,45 @@ func (_m *PChainClient) GetNodeID(_a0 context.Context, _a1 ...rpc.Option) (ids.N
 	return r0, r1, r2
 }
 
+// GetPendingValidators provides a mock function with given fields: ctx, subnetID, nodeIDs, options
+func (_m *PChainClient) GetPendingValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]interface{}, []interface{}, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, subnetID, nodeIDs)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []interface{}
+	if rf, ok := ret.Get(0).(func(context.Context, ids.ID, []ids.NodeID, ...rpc.Option) []interface{}); ok {
+		r0 = rf(ctx, subnetID, nodeIDs, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	var r1 []interface{}
+	if rf, ok := ret.Get(1).(func(context.Context, ids.ID, []ids.NodeID, ...rpc.Option) []interface{}); ok {
+		r1 = rf(ctx, subnetID, nodeIDs, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]interface{})
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, ids.ID, []ids.NodeID, ...rpc.Option) error); ok {
+		r2 = rf(ctx, subnetID, nodeIDs, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetRewardUTXOs provides a mock function with given fields: _a0, _a1, _a2
 func (_m *PChainClient) GetRewardUTXOs(_a0 context.Context, _a1 *api.GetTxArgs, _a2 ...rpc.Option) ([][]byte, error) {
 	_va := make([]interface{}, len(_a2))
@@ -504,6 +573,36 @@ func (_m *PChainClient) GetTxFee(_a0 context.Context, _a1 ...rpc.Option) (*info.
 	return r0, r1
 }
 
+// GetTxStatus provides a mock function with given fields: ctx, txID, options
+func (_m *PChainClient) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (*platformvm.GetTxStatusResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, txID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *platformvm.GetTxStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ids.ID, ...rpc.Option) *platformvm.GetTxStatusResponse); ok {
+		r0 = rf(ctx, txID, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*platformvm.GetTxStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ids.ID, ...rpc.Option) error); ok {
+		r1 = rf(ctx, txID, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUTXOs provides a mock function with given fields: ctx, addrs, limit, startAddress, startUTXOID, options
 func (_m *PChainClient) GetUTXOs(ctx context.Context, addrs []ids.ShortID, limit uint32, startAddress ids.ShortID, startUTXOID ids.ID, options ...rpc.Option) ([][]byte, ids.ShortID, ids.ID, error) {
 	_va := make([]interface{}, len(options))
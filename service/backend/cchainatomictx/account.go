@@ -10,8 +10,12 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	pBlocks "github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/coinbase/rosetta-sdk-go/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/ava-labs/avalanche-rosetta/client"
 	"github.com/ava-labs/avalanche-rosetta/mapper"
+	cmapper "github.com/ava-labs/avalanche-rosetta/mapper/cchainatomictx"
 	"github.com/ava-labs/avalanche-rosetta/service"
 	"github.com/ava-labs/avalanche-rosetta/service/backend/common"
 )
@@ -59,24 +63,103 @@ func (b *Backend) AccountCoins(ctx context.Context, req *types.AccountCoinsReque
 	if req.AccountIdentifier == nil {
 		return nil, service.WrapError(service.ErrInvalidInput, "account identifier is not provided")
 	}
-	blockIdentifier, coins, wrappedErr := b.getAccountCoins(ctx, req.AccountIdentifier.Address)
+	accountAddress, wrappedErr := b.getAtomicAddress(req.NetworkIdentifier, req.AccountIdentifier)
+	if wrappedErr != nil {
+		return nil, wrappedErr
+	}
+
+	blockIdentifier, coins, wrappedErr := b.getAccountCoins(ctx, accountAddress)
 	if wrappedErr != nil {
 		return nil, wrappedErr
 	}
 
-	return &types.AccountCoinsResponse{
+	resp := &types.AccountCoinsResponse{
 		BlockIdentifier: blockIdentifier,
 		Coins:           common.SortUnique(coins),
-	}, nil
+	}
+	if accountAddress != req.AccountIdentifier.Address {
+		resp.Metadata = map[string]interface{}{
+			cmapper.MetadataBech32Address: accountAddress,
+		}
+	}
+	return resp, nil
+}
+
+// getAtomicAddress returns the bech32 address owning the atomic UTXOs of an account,
+// which is either that address or a 0x address carrying its public key or its
+// bech32 address in metadata
+func (b *Backend) getAtomicAddress(
+	networkIdentifier *types.NetworkIdentifier,
+	accountIdentifier *types.AccountIdentifier,
+) (string, *types.Error) {
+	if cmapper.IsCChainBech32Address(accountIdentifier) {
+		return accountIdentifier.Address, nil
+	}
+
+	var metadata cmapper.AccountMetadata
+	if err := mapper.UnmarshalJSONMap(accountIdentifier.Metadata, &metadata); err != nil {
+		return "", service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	if metadata.PublicKey == nil {
+		if !cmapper.IsCChainBech32Address(&types.AccountIdentifier{Address: metadata.Bech32Address}) {
+			return "", service.WrapError(service.ErrInvalidInput, "bech32_address is not a C-chain address")
+		}
+		return metadata.Bech32Address, nil
+	}
+
+	// The public key must be the one of the 0x address for its UTXOs to be importable by the account
+	ethKey, err := ethcrypto.DecompressPubkey(metadata.PublicKey.Bytes)
+	if err != nil {
+		return "", service.WrapError(service.ErrInvalidInput, err)
+	}
+	if ethcrypto.PubkeyToAddress(*ethKey) != ethcommon.HexToAddress(accountIdentifier.Address) {
+		return "", service.WrapError(service.ErrInvalidInput, "public key does not match account address")
+	}
+
+	deriveResp, wrappedErr := common.DeriveBech32Address(b.fac, mapper.CChainNetworkIdentifier, &types.ConstructionDeriveRequest{
+		NetworkIdentifier: networkIdentifier,
+		PublicKey:         metadata.PublicKey,
+	})
+	if wrappedErr != nil {
+		return "", wrappedErr
+	}
+
+	bech32Address := deriveResp.AccountIdentifier.Address
+	if metadata.Bech32Address != "" && metadata.Bech32Address != bech32Address {
+		return "", service.WrapError(service.ErrInvalidInput, "bech32_address does not match public key")
+	}
+	return bech32Address, nil
 }
 
+// getAccountCoins fetches the atomic UTXOs of [address], retrying while new
+// blocks are received during the fetch
 func (b *Backend) getAccountCoins(ctx context.Context, address string) (*types.BlockIdentifier, []*types.Coin, *types.Error) {
+	var (
+		blockIdentifier *types.BlockIdentifier
+		coins           []*types.Coin
+	)
+	wrappedErr := common.RetryOnChainAdvance(ctx, b.utxoFetchAttempts, func() *types.Error {
+		var wrappedErr *types.Error
+		blockIdentifier, coins, wrappedErr = b.getAccountCoinsAtHeight(ctx, address)
+		return wrappedErr
+	})
+	if wrappedErr != nil {
+		return nil, nil, wrappedErr
+	}
+	return blockIdentifier, coins, nil
+}
+
+func (b *Backend) getAccountCoinsAtHeight(ctx context.Context, address string) (*types.BlockIdentifier, []*types.Coin, *types.Error) {
 	var coins []*types.Coin
 	sourceChains := []string{
 		mapper.PChainNetworkIdentifier,
 		mapper.XChainNetworkIdentifier,
 	}
 
+	// read the headers and all the UTXO pages from the same node
+	ctx = client.WithPinnedNode(ctx)
+
 	preHeader, err := b.cClient.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return nil, nil, service.WrapError(service.ErrInternalError, err)
@@ -98,7 +181,7 @@ func (b *Backend) getAccountCoins(ctx context.Context, address string) (*types.B
 	// Since there is no API to return coins and block height at the time of query, we lookup block height before and after
 	// and fail the request if theyd differ since it means we don't know which block the coins are at
 	if preHeader.Number.Cmp(postHeader.Number) != 0 {
-		return nil, nil, service.WrapError(service.ErrInternalError, "new block received while fetching coins")
+		return nil, nil, service.WrapError(service.ErrChainAdvanced, "new block received while fetching coins")
 	}
 
 	blockIdentifier := &types.BlockIdentifier{
@@ -8,15 +8,20 @@ import (
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	ethtypes "github.com/ava-labs/coreth/core/types"
 	"github.com/coinbase/rosetta-sdk-go/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ava-labs/avalanche-rosetta/mapper"
+	cmapper "github.com/ava-labs/avalanche-rosetta/mapper/cchainatomictx"
 	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+	"github.com/ava-labs/avalanche-rosetta/service"
 )
 
 type utxo struct {
@@ -125,6 +130,140 @@ func TestAccountCoins(t *testing.T) {
 	})
 }
 
+func TestAccountCoinsChainAdvance(t *testing.T) {
+	accountAddress := "C-fuji15f9g0h5xkr5cp47n6u3qxj6yjtzzzrdr23a3tl"
+	req := &types.AccountCoinsRequest{
+		NetworkIdentifier: &types.NetworkIdentifier{},
+		AccountIdentifier: &types.AccountIdentifier{
+			Address: accountAddress,
+		},
+	}
+	nextBlockHeader := &ethtypes.Header{Number: big.NewInt(43)}
+
+	newBackend := func() (*mocks.Client, *Backend) {
+		evmMock := &mocks.Client{}
+		backend := NewBackend(evmMock, ids.Empty)
+		backend.SetUTXOFetchAttempts(2)
+		evmMock.
+			On("GetAtomicUTXOs", mock.Anything, []string{accountAddress}, "P", backend.getUTXOsPageSize, "", "").
+			Return([][]byte{makeUtxoBytes(t, backend, utxos[0].id, utxos[0].amount)}, api.Index{}, nil).Twice()
+		evmMock.
+			On("GetAtomicUTXOs", mock.Anything, []string{accountAddress}, "X", backend.getUTXOsPageSize, "", "").
+			Return([][]byte{}, api.Index{}, nil).Twice()
+		return evmMock, backend
+	}
+
+	t.Run("fetch is retried when a new block is received", func(t *testing.T) {
+		evmMock, backend := newBackend()
+		var nilBigInt *big.Int
+		evmMock.On("HeaderByNumber", mock.Anything, nilBigInt).Return(blockHeader, nil).Once()
+		evmMock.On("HeaderByNumber", mock.Anything, nilBigInt).Return(nextBlockHeader, nil).Times(3)
+
+		resp, apiErr := backend.AccountCoins(context.Background(), req)
+		assert.Nil(t, apiErr)
+		assert.Equal(t, int64(43), resp.BlockIdentifier.Index)
+		assert.Equal(t, 1, len(resp.Coins))
+		evmMock.AssertExpectations(t)
+	})
+
+	t.Run("retriable error is returned when attempts are exhausted", func(t *testing.T) {
+		evmMock, backend := newBackend()
+		var nilBigInt *big.Int
+		evmMock.On("HeaderByNumber", mock.Anything, nilBigInt).Return(blockHeader, nil).Once()
+		evmMock.On("HeaderByNumber", mock.Anything, nilBigInt).Return(nextBlockHeader, nil).Twice()
+		evmMock.On("HeaderByNumber", mock.Anything, nilBigInt).Return(&ethtypes.Header{Number: big.NewInt(44)}, nil).Once()
+
+		resp, apiErr := backend.AccountCoins(context.Background(), req)
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrChainAdvanced.Code, apiErr.Code)
+		assert.True(t, apiErr.Retriable)
+		evmMock.AssertExpectations(t)
+	})
+}
+
+func TestAccountCoinsHexAddress(t *testing.T) {
+	evmMock := &mocks.Client{}
+	backend := NewBackend(evmMock, ids.Empty)
+	networkIdentifier := &types.NetworkIdentifier{Network: mapper.FujiNetwork}
+
+	key, err := ethcrypto.GenerateKey()
+	assert.Nil(t, err)
+	publicKeyBytes := ethcrypto.CompressPubkey(&key.PublicKey)
+	publicKey := &types.PublicKey{Bytes: publicKeyBytes, CurveType: types.Secp256k1}
+	hexAddress := ethcrypto.PubkeyToAddress(key.PublicKey).Hex()
+
+	avaxKey, err := backend.fac.ToPublicKey(publicKeyBytes)
+	assert.Nil(t, err)
+	accountAddress, err := address.Format(mapper.CChainNetworkIdentifier, constants.FujiHRP, avaxKey.Address().Bytes())
+	assert.Nil(t, err)
+
+	var nilBigInt *big.Int
+	evmMock.On("HeaderByNumber", mock.Anything, nilBigInt).Return(blockHeader, nil)
+	evmMock.
+		On("GetAtomicUTXOs", mock.Anything, []string{accountAddress}, "P", backend.getUTXOsPageSize, "", "").
+		Return([][]byte{makeUtxoBytes(t, backend, utxos[0].id, utxos[0].amount)}, api.Index{}, nil)
+	evmMock.
+		On("GetAtomicUTXOs", mock.Anything, []string{accountAddress}, "X", backend.getUTXOsPageSize, "", "").
+		Return([][]byte{makeUtxoBytes(t, backend, utxos[1].id, utxos[1].amount)}, api.Index{}, nil)
+
+	t.Run("UTXOs of the public key are returned", func(t *testing.T) {
+		publicKeyMetadata, _ := mapper.MarshalJSONMap(cmapper.AccountMetadata{PublicKey: publicKey})
+
+		resp, apiErr := backend.AccountCoins(context.Background(), &types.AccountCoinsRequest{
+			NetworkIdentifier: networkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address:  hexAddress,
+				Metadata: publicKeyMetadata,
+			},
+		})
+		assert.Nil(t, apiErr)
+		assert.Equal(t, 2, len(resp.Coins))
+		assert.Equal(t, map[string]interface{}{cmapper.MetadataBech32Address: accountAddress}, resp.Metadata)
+	})
+
+	t.Run("UTXOs of the bech32 address are returned", func(t *testing.T) {
+		resp, apiErr := backend.AccountCoins(context.Background(), &types.AccountCoinsRequest{
+			NetworkIdentifier: networkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: hexAddress,
+				Metadata: map[string]interface{}{
+					cmapper.MetadataBech32Address: accountAddress,
+				},
+			},
+		})
+		assert.Nil(t, apiErr)
+		assert.Equal(t, 2, len(resp.Coins))
+	})
+
+	t.Run("public key of another address is rejected", func(t *testing.T) {
+		publicKeyMetadata, _ := mapper.MarshalJSONMap(cmapper.AccountMetadata{PublicKey: publicKey})
+
+		resp, apiErr := backend.AccountCoins(context.Background(), &types.AccountCoinsRequest{
+			NetworkIdentifier: networkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address:  "0x30cE0c38f953eE9CD5fbc247e63DE68D3263144b",
+				Metadata: publicKeyMetadata,
+			},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrInvalidInput.Code, apiErr.Code)
+	})
+
+	t.Run("bech32 address of another chain is rejected", func(t *testing.T) {
+		resp, apiErr := backend.AccountCoins(context.Background(), &types.AccountCoinsRequest{
+			NetworkIdentifier: networkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: hexAddress,
+				Metadata: map[string]interface{}{
+					cmapper.MetadataBech32Address: "P-fuji15f9g0h5xkr5cp47n6u3qxj6yjtzzzrdr23a3tl",
+				},
+			},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrInvalidInput.Code, apiErr.Code)
+	})
+}
+
 func makeUtxoBytes(t *testing.T, backend *Backend, utxoIDStr string, amount uint64) []byte {
 	utxoID, err := mapper.DecodeUTXOID(utxoIDStr)
 	if err != nil {
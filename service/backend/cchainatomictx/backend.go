@@ -11,6 +11,7 @@ import (
 	"github.com/ava-labs/avalanche-rosetta/mapper"
 	cmapper "github.com/ava-labs/avalanche-rosetta/mapper/cchainatomictx"
 	"github.com/ava-labs/avalanche-rosetta/service"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/common"
 )
 
 var (
@@ -19,31 +20,39 @@ var (
 )
 
 type Backend struct {
-	fac              *crypto.FactorySECP256K1R
-	cClient          client.Client
-	getUTXOsPageSize uint32
-	codec            codec.Manager
-	codecVersion     uint16
-	avaxAssetID      ids.ID
+	fac               *crypto.FactorySECP256K1R
+	cClient           client.Client
+	getUTXOsPageSize  uint32
+	utxoFetchAttempts int
+	codec             codec.Manager
+	codecVersion      uint16
+	avaxAssetID       ids.ID
 }
 
 func NewBackend(cClient client.Client, avaxAssetID ids.ID) *Backend {
 	return &Backend{
-		fac:              &crypto.FactorySECP256K1R{},
-		cClient:          cClient,
-		getUTXOsPageSize: 1024,
-		codec:            evm.Codec,
-		codecVersion:     0,
-		avaxAssetID:      avaxAssetID,
+		fac:               &crypto.FactorySECP256K1R{},
+		cClient:           cClient,
+		getUTXOsPageSize:  1024,
+		utxoFetchAttempts: common.DefaultUTXOFetchAttempts,
+		codec:             evm.Codec,
+		codecVersion:      0,
+		avaxAssetID:       avaxAssetID,
 	}
 }
 
+// SetUTXOFetchAttempts sets the number of times atomic UTXOs are fetched when
+// new blocks keep being received during the fetch
+func (b *Backend) SetUTXOFetchAttempts(attempts int) {
+	b.utxoFetchAttempts = attempts
+}
+
 func (b *Backend) ShouldHandleRequest(req interface{}) bool {
 	switch r := req.(type) {
 	case *types.AccountBalanceRequest:
 		return cmapper.IsCChainBech32Address(r.AccountIdentifier)
 	case *types.AccountCoinsRequest:
-		return cmapper.IsCChainBech32Address(r.AccountIdentifier)
+		return cmapper.IsCChainBech32Address(r.AccountIdentifier) || cmapper.IsCChainHexAtomicAccount(r.AccountIdentifier)
 	case *types.ConstructionDeriveRequest:
 		return r.Metadata[mapper.MetadataAddressFormat] == mapper.AddressFormatBech32
 	case *types.ConstructionMetadataRequest:
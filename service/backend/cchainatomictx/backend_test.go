@@ -47,6 +47,15 @@ func TestShouldHandleRequest(t *testing.T) {
 			NetworkIdentifier: cChainNetworkIdentifier,
 			AccountIdentifier: bech32AccountIdentifier,
 		}))
+		assert.True(t, backend.ShouldHandleRequest(&types.AccountCoinsRequest{
+			NetworkIdentifier: cChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: evmAccountIdentifier.Address,
+				Metadata: map[string]interface{}{
+					cmapper.MetadataBech32Address: bech32AccountIdentifier.Address,
+				},
+			},
+		}))
 		assert.True(t, backend.ShouldHandleRequest(&types.ConstructionDeriveRequest{
 			NetworkIdentifier: cChainNetworkIdentifier,
 			Metadata: map[string]interface{}{
@@ -91,6 +100,15 @@ func TestShouldHandleRequest(t *testing.T) {
 			NetworkIdentifier: cChainNetworkIdentifier,
 			AccountIdentifier: evmAccountIdentifier,
 		}))
+		assert.False(t, backend.ShouldHandleRequest(&types.AccountBalanceRequest{
+			NetworkIdentifier: cChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: evmAccountIdentifier.Address,
+				Metadata: map[string]interface{}{
+					cmapper.MetadataBech32Address: bech32AccountIdentifier.Address,
+				},
+			},
+		}))
 		assert.False(t, backend.ShouldHandleRequest(&types.AccountCoinsRequest{
 			NetworkIdentifier: cChainNetworkIdentifier,
 			AccountIdentifier: evmAccountIdentifier,
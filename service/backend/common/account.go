@@ -1,11 +1,24 @@
 package common
 
 import (
+	"context"
+	"math/rand"
 	"sort"
+	"time"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/service"
 )
 
+// DefaultUTXOFetchAttempts is the number of times UTXOs are fetched when the chain
+// keeps advancing during the fetch, unless configured otherwise
+const DefaultUTXOFetchAttempts = 3
+
+// utxoFetchRetryDelay is the maximum delay before the first retry of a UTXO fetch,
+// doubled for each following retry
+var utxoFetchRetryDelay = 100 * time.Millisecond
+
 // SortUnique deduplicates given slice of coins and sorts them by UTXO id in ascending order for consistency
 //
 // Per https://docs.avax.network/apis/avalanchego/apis/p-chain#platformgetutxos
@@ -30,3 +43,31 @@ func SortUnique(coins []*types.Coin) []*types.Coin {
 
 	return uniqueCoins
 }
+
+// RetryOnChainAdvance calls [fetch] up to [attempts] times while it fails with
+// service.ErrChainAdvanced. Retries wait for a random delay so that concurrent
+// requests do not race against the same blocks again.
+func RetryOnChainAdvance(ctx context.Context, attempts int, fetch func() *types.Error) *types.Error {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err *types.Error
+	maxDelay := utxoFetchRetryDelay
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(time.Duration(rand.Int63n(int64(maxDelay)))): //nolint:gosec
+			}
+			maxDelay *= 2
+		}
+
+		err = fetch()
+		if err == nil || err.Code != service.ErrChainAdvanced.Code {
+			return err
+		}
+	}
+	return err
+}
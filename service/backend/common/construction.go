@@ -11,6 +11,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/coinbase/rosetta-sdk-go/parser"
 	"github.com/coinbase/rosetta-sdk-go/types"
@@ -130,10 +131,21 @@ func BuildPayloads(
 			coinIdentifier = o.CoinChange.CoinIdentifier.Identifier
 		}
 
-		accountIdentifierSigners = append(accountIdentifierSigners, Signer{
+		signer := Signer{
 			CoinIdentifier:    coinIdentifier,
 			AccountIdentifier: o.Account,
-		})
+		}
+
+		// Multisig P-chain inputs are signed by their owners at the signature indices
+		if opMetadata, err := pmapper.ParseOpMetadata(o.Metadata); err == nil && len(opMetadata.Owners) > 0 {
+			ownerSigners, err := pmapper.InputSigners(o, opMetadata)
+			if err != nil {
+				return nil, service.WrapError(service.ErrInvalidInput, err)
+			}
+			signer.OwnerSigners = ownerSigners
+		}
+
+		accountIdentifierSigners = append(accountIdentifierSigners, signer)
 	}
 
 	rosettaTx := &RosettaTx{
@@ -167,6 +179,12 @@ func BuildPayloads(
 		rosettaTx.DestinationChainID = &metadata.DestinationChainID
 	}
 
+	if metadata.SubnetMetadata != nil {
+		for _, addr := range metadata.SubnetMetadata.SubnetAuthSigners {
+			rosettaTx.SubnetAuthSigners = append(rosettaTx.SubnetAuthSigners, &types.AccountIdentifier{Address: addr})
+		}
+	}
+
 	txJSON, err := json.Marshal(rosettaTx)
 	if err != nil {
 		return nil, service.WrapError(service.ErrInternalError, err)
@@ -236,6 +254,7 @@ func Combine(
 		AccountIdentifierSigners: rosettaTx.AccountIdentifierSigners,
 		DestinationChain:         rosettaTx.DestinationChain,
 		DestinationChainID:       rosettaTx.DestinationChainID,
+		SubnetAuthSigners:        rosettaTx.SubnetAuthSigners,
 	})
 	if err != nil {
 		return nil, service.WrapError(service.ErrInternalError, "unable to encode signed transaction")
@@ -255,7 +274,12 @@ func BuildCredentialList(ins []*avax.TransferableInput, signatures []*types.Sign
 	creds := make([]verify.Verifiable, len(ins))
 	sigOffset := 0
 	for i, transferInput := range ins {
-		input, ok := transferInput.In.(*secp256k1fx.TransferInput)
+		in := transferInput.In
+		if lockIn, ok := in.(*stakeable.LockIn); ok {
+			in = lockIn.TransferableIn
+		}
+
+		input, ok := in.(*secp256k1fx.TransferInput)
 		if !ok {
 			return nil, errInvalidInput
 		}
@@ -275,6 +299,33 @@ func BuildCredentialList(ins []*avax.TransferableInput, signatures []*types.Sign
 	return creds, nil
 }
 
+// BuildCredentialListWithAuth builds the credentials of [ins] followed by the
+// credential of [auth], such as the subnet auth of P-chain subnet txs, whose
+// signatures come after the signatures of the inputs.
+func BuildCredentialListWithAuth(
+	ins []*avax.TransferableInput,
+	auth *secp256k1fx.Input,
+	signatures []*types.Signature,
+) ([]verify.Verifiable, error) {
+	numInputSigs := len(signatures) - len(auth.SigIndices)
+	if numInputSigs < 0 {
+		return nil, errInsufficientSignatures
+	}
+
+	creds, err := BuildCredentialList(ins, signatures[:numInputSigs])
+	if err != nil {
+		return nil, err
+	}
+
+	sigOffset := numInputSigs
+	cred, err := buildCredential(len(auth.SigIndices), &sigOffset, signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(creds, cred), nil
+}
+
 func BuildSingletonCredentialList(signatures []*types.Signature) ([]verify.Verifiable, error) {
 	offset := 0
 	cred, err := buildCredential(1, &offset, signatures)
@@ -27,11 +27,18 @@ type RosettaTx struct {
 
 	DestinationChain   string
 	DestinationChainID *ids.ID
+
+	// SubnetAuthSigners sign P-chain subnet transactions on behalf of the
+	// subnet owners, after the signers of the inputs
+	SubnetAuthSigners []*types.AccountIdentifier
 }
 
 type Signer struct {
 	CoinIdentifier    string                   `json:"coin_identifier,omitempty"`
 	AccountIdentifier *types.AccountIdentifier `json:"account_identifier"`
+
+	// OwnerSigners are the owners signing an input spending a multisig UTXO
+	OwnerSigners []*types.AccountIdentifier `json:"owner_signers,omitempty"`
 }
 
 type rosettaTxWire struct {
@@ -39,6 +46,8 @@ type rosettaTxWire struct {
 	Signers            []Signer `json:"signers"`
 	DestinationChain   string   `json:"destination_chain,omitempty"`
 	DestinationChainID *ids.ID  `json:"destination_chain_id,omitempty"`
+
+	SubnetAuthSigners []*types.AccountIdentifier `json:"subnet_auth_signers,omitempty"`
 }
 
 func (t *RosettaTx) MarshalJSON() ([]byte, error) {
@@ -57,6 +66,7 @@ func (t *RosettaTx) MarshalJSON() ([]byte, error) {
 		Signers:            t.AccountIdentifierSigners,
 		DestinationChain:   t.DestinationChain,
 		DestinationChainID: t.DestinationChainID,
+		SubnetAuthSigners:  t.SubnetAuthSigners,
 	}
 	return json.Marshal(txWire)
 }
@@ -84,6 +94,7 @@ func (t *RosettaTx) UnmarshalJSON(data []byte) error {
 	t.AccountIdentifierSigners = txWire.Signers
 	t.DestinationChain = txWire.DestinationChain
 	t.DestinationChainID = txWire.DestinationChainID
+	t.SubnetAuthSigners = txWire.SubnetAuthSigners
 
 	return nil
 }
@@ -91,9 +102,9 @@ func (t *RosettaTx) UnmarshalJSON(data []byte) error {
 func (t *RosettaTx) GetAccountIdentifiers(operations []*types.Operation) ([]*types.AccountIdentifier, error) {
 	signers := []*types.AccountIdentifier{}
 
-	operationToAccountMap := make(map[string]*types.AccountIdentifier)
+	operationToSignerMap := make(map[string]Signer)
 	for _, data := range t.AccountIdentifierSigners {
-		operationToAccountMap[data.CoinIdentifier] = data.AccountIdentifier
+		operationToSignerMap[data.CoinIdentifier] = data
 	}
 
 	for _, op := range operations {
@@ -107,12 +118,18 @@ func (t *RosettaTx) GetAccountIdentifiers(operations []*types.Operation) ([]*typ
 			coinIdentifier = op.CoinChange.CoinIdentifier.Identifier
 		}
 
-		signer := operationToAccountMap[coinIdentifier]
-		if signer == nil {
+		signer, ok := operationToSignerMap[coinIdentifier]
+		if !ok || signer.AccountIdentifier == nil {
 			return nil, errors.New("not all operations have signers")
 		}
-		signers = append(signers, signer)
+		if len(signer.OwnerSigners) > 0 {
+			signers = append(signers, signer.OwnerSigners...)
+			continue
+		}
+		signers = append(signers, signer.AccountIdentifier)
 	}
 
+	signers = append(signers, t.SubnetAuthSigners...)
+
 	return signers, nil
 }
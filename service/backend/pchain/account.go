@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 
@@ -18,57 +19,46 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/coinbase/rosetta-sdk-go/types"
 
+	"github.com/ava-labs/avalanche-rosetta/client"
 	"github.com/ava-labs/avalanche-rosetta/mapper"
 	"github.com/ava-labs/avalanche-rosetta/service"
 )
 
 var (
-	errUnableToGetUTXOs           = errors.New("unable to get UTXOs")
-	errUnableToParseUTXO          = errors.New("unable to parse UTXO")
-	errUnableToGetUTXOOut         = errors.New("unable to get UTXO output")
-	errTotalOverflow              = errors.New("overflow while calculating total balance")
-	errUnlockedOverflow           = errors.New("overflow while calculating unlocked balance")
-	errLockedOverflow             = errors.New("overflow while calculating locked balance")
-	errNotStakeableOverflow       = errors.New("overflow while calculating locked not stakeable balance")
-	errLockedNotStakeableOverflow = errors.New("overflow while calculating locked not stakeable balance")
-	errUnlockedStakeableOverflow  = errors.New("overflow while calculating unlocked stakeable balance")
+	errUnableToGetUTXOs          = errors.New("unable to get UTXOs")
+	errUnableToParseUTXO         = errors.New("unable to parse UTXO")
+	errUnableToGetUTXOOut        = errors.New("unable to get UTXO output")
+	errTotalOverflow             = errors.New("overflow while calculating total balance")
+	errUnlockedOverflow          = errors.New("overflow while calculating unlocked balance")
+	errLockedOverflow            = errors.New("overflow while calculating locked balance")
+	errNotStakeableOverflow      = errors.New("overflow while calculating locked not stakeable balance")
+	errUnlockedStakeableOverflow = errors.New("overflow while calculating unlocked stakeable balance")
 )
 
 func (b *Backend) AccountBalance(ctx context.Context, req *types.AccountBalanceRequest) (*types.AccountBalanceResponse, *types.Error) {
 	if req.AccountIdentifier == nil {
 		return nil, service.WrapError(service.ErrInvalidInput, "account identifier is not provided")
 	}
-	if req.BlockIdentifier != nil {
-		return nil, service.WrapError(service.ErrNotSupported, "historical balance lookups are not supported")
-	}
-
 	var balanceType string
 	if req.AccountIdentifier.SubAccount != nil {
 		balanceType = req.AccountIdentifier.SubAccount.Address
 	}
+
+	if req.BlockIdentifier != nil {
+		return b.indexedAccountBalance(req.AccountIdentifier.Address, balanceType, req.BlockIdentifier)
+	}
+
 	fetchImportable := balanceType == pmapper.SubAccountTypeSharedMemory
+	multisig := balanceType == pmapper.SubAccountTypeMultisig
 
-	height, balance, typedErr := b.fetchBalance(ctx, req.AccountIdentifier.Address, fetchImportable)
+	height, balance, typedErr := b.fetchBalance(ctx, req.AccountIdentifier.Address, fetchImportable, multisig)
 	if typedErr != nil {
 		return nil, typedErr
 	}
 
-	var balanceValue uint64
-	switch balanceType {
-	case pmapper.SubAccountTypeUnlocked:
-		balanceValue = balance.Unlocked
-	case pmapper.SubAccountTypeLockedStakeable:
-		balanceValue = balance.LockedStakeable
-	case pmapper.SubAccountTypeLockedNotStakeable:
-		balanceValue = balance.LockedNotStakeable
-	case pmapper.SubAccountTypeStaked:
-		balanceValue = balance.Staked
-	case pmapper.SubAccountTypeSharedMemory:
-		balanceValue = balance.Total
-	case "": // Defaults to total balance
-		balanceValue = balance.Total
-	default:
-		return nil, service.WrapError(service.ErrInvalidInput, "unknown account type "+balanceType)
+	balanceValue, typedErr := getBalanceOfType(balance, balanceType)
+	if typedErr != nil {
+		return nil, typedErr
 	}
 
 	block, err := b.getBlockDetails(ctx, int64(height), "")
@@ -104,16 +94,48 @@ func (b *Backend) AccountCoins(ctx context.Context, req *types.AccountCoinsReque
 		return nil, wrappedErr
 	}
 
+	var accountMetadata pmapper.AccountCoinsMetadata
+	if err := mapper.UnmarshalJSONMap(req.AccountIdentifier.Metadata, &accountMetadata); err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
 	var subAccountAddress string
 	if req.AccountIdentifier.SubAccount != nil {
 		subAccountAddress = req.AccountIdentifier.SubAccount.Address
 	}
 	fetchSharedMemory := subAccountAddress == pmapper.SubAccountTypeSharedMemory
+	multisig := subAccountAddress == pmapper.SubAccountTypeMultisig
 
-	height, utxos, _, typedErr := b.fetchUTXOsAndStakedOutputs(ctx, addr, false, fetchSharedMemory)
+	switch subAccountAddress {
+	case "", pmapper.SubAccountTypeSharedMemory, pmapper.SubAccountTypeMultisig, pmapper.SubAccountTypeUnlocked,
+		pmapper.SubAccountTypeLockedStakeable, pmapper.SubAccountTypeLockedNotStakeable:
+	default:
+		return nil, service.WrapError(service.ErrInvalidInput, "unknown coins account type "+subAccountAddress)
+	}
+
+	hrp, err := mapper.GetHRP(req.NetworkIdentifier)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, "incorrect network identifier")
+	}
+
+	if accountMetadata.BlockIdentifier != nil {
+		if fetchSharedMemory || multisig {
+			return nil, service.WrapError(service.ErrNotSupported, "historical shared memory and multisig coins are not supported")
+		}
+		return b.indexedAccountCoins(
+			req.AccountIdentifier.Address,
+			subAccountAddress,
+			hrp,
+			currencyAssetIDs,
+			accountMetadata.BlockIdentifier,
+		)
+	}
+
+	height, utxos, _, typedErr := b.fetchUTXOsAndStakedOutputs(ctx, addr, false, fetchSharedMemory, multisig)
 	if typedErr != nil {
 		return nil, typedErr
 	}
+	utxos = filterUTXOsByLockState(utxos, subAccountAddress, uint64(time.Now().Unix()))
 
 	// convert raw UTXO bytes to Rosetta Coins
 	coins, err := b.processUtxos(currencyAssetIDs, utxos)
@@ -121,6 +143,11 @@ func (b *Backend) AccountCoins(ctx context.Context, req *types.AccountCoinsReque
 		return nil, service.WrapError(service.ErrInternalError, err)
 	}
 
+	metadata, err := buildCoinsMetadata(coins, utxos, hrp)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+
 	block, err := b.getBlockDetails(ctx, int64(height), "")
 	if err != nil {
 		return nil, service.WrapError(service.ErrInvalidInput, "unable to get height")
@@ -131,22 +158,33 @@ func (b *Backend) AccountCoins(ctx context.Context, req *types.AccountCoinsReque
 			Index: int64(height),
 			Hash:  block.BlockID.String(),
 		},
-		Coins: common.SortUnique(coins),
+		Coins:    common.SortUnique(coins),
+		Metadata: metadata,
 	}, nil
 }
 
-func (b *Backend) fetchBalance(ctx context.Context, addrString string, fetchImportable bool) (uint64, *AccountBalance, *types.Error) {
+// fetchBalance returns the balance of the UTXOs owned by [addrString] alone or,
+// when [multisig] is set, of the multisig UTXOs it co-owns. The full amount of
+// multisig UTXOs is attributed to each of their owners, and their staked
+// outputs are not reported.
+func (b *Backend) fetchBalance(
+	ctx context.Context,
+	addrString string,
+	fetchImportable bool,
+	multisig bool,
+) (uint64, *AccountBalance, *types.Error) {
 	addr, err := address.ParseToID(addrString)
 	if err != nil {
 		return 0, nil, service.WrapError(service.ErrInvalidInput, "unable to convert address")
 	}
 
-	height, utxos, stakedUTXOBytes, typedErr := b.fetchUTXOsAndStakedOutputs(ctx, addr, !fetchImportable, fetchImportable)
+	fetchStaked := !fetchImportable && !multisig
+	height, utxos, stakedUTXOBytes, typedErr := b.fetchUTXOsAndStakedOutputs(ctx, addr, fetchStaked, fetchImportable, multisig)
 	if typedErr != nil {
 		return 0, nil, typedErr
 	}
 
-	balance, err := b.getBalancesWithoutMultisig(utxos)
+	balance, err := b.getBalancesWithoutMultisig(utxos, uint64(time.Now().Unix()))
 	if err != nil {
 		return 0, nil, service.WrapError(service.ErrInternalError, err)
 	}
@@ -163,12 +201,31 @@ func (b *Backend) fetchBalance(ctx context.Context, addrString string, fetchImpo
 	return height, balance, nil
 }
 
+func getBalanceOfType(balance *AccountBalance, balanceType string) (uint64, *types.Error) {
+	switch balanceType {
+	case pmapper.SubAccountTypeUnlocked:
+		return balance.Unlocked, nil
+	case pmapper.SubAccountTypeLockedStakeable:
+		return balance.LockedStakeable, nil
+	case pmapper.SubAccountTypeLockedNotStakeable:
+		return balance.LockedNotStakeable, nil
+	case pmapper.SubAccountTypeStaked:
+		return balance.Staked, nil
+	case pmapper.SubAccountTypeSharedMemory, pmapper.SubAccountTypeMultisig:
+		return balance.Total, nil
+	case "": // Defaults to total balance
+		return balance.Total, nil
+	default:
+		return 0, service.WrapError(service.ErrInvalidInput, "unknown account type "+balanceType)
+	}
+}
+
 // Copy of the platformvm service's GetBalance implementation.
-// This is needed as multisig UTXOs are cleaned in parseUTXOs and its output must be used for the calculations. Ref:
+// This is needed as multisig and single owner UTXOs are separated in parseUTXOs and its output must be used for the calculations. Ref:
 // https://github.com/ava-labs/avalanchego/blob/0950acab667e0c16a55e9a9bb72bcbe25c3b88cf/vms/platformvm/service.go#L184
-func (b *Backend) getBalancesWithoutMultisig(utxos []avax.UTXO) (*AccountBalance, error) {
-	currentTime := uint64(time.Now().Unix())
-
+//
+// Locks are evaluated by getLockState at [currentTime], in unix seconds.
+func (b *Backend) getBalancesWithoutMultisig(utxos []avax.UTXO, currentTime uint64) (*AccountBalance, error) {
 	accountBalance := &AccountBalance{
 		Total:              0,
 		Staked:             0,
@@ -177,49 +234,32 @@ func (b *Backend) getBalancesWithoutMultisig(utxos []avax.UTXO) (*AccountBalance
 		LockedNotStakeable: 0,
 	}
 
-utxoFor:
 	for _, utxo := range utxos {
-		switch out := utxo.Out.(type) {
-		case *secp256k1fx.TransferOutput:
-			if out.Locktime <= currentTime {
-				newBalance, err := math.Add64(accountBalance.Unlocked, out.Amount())
-				if err != nil {
-					return nil, errUnlockedOverflow
-				}
-				accountBalance.Unlocked = newBalance
-			} else {
-				newBalance, err := math.Add64(accountBalance.LockedNotStakeable, out.Amount())
-				if err != nil {
-					return nil, errNotStakeableOverflow
-				}
-				accountBalance.LockedNotStakeable = newBalance
+		lockState, ok := getLockState(utxo.Out, currentTime)
+		if !ok {
+			continue
+		}
+
+		amount := utxo.Out.(avax.Amounter).Amount()
+		switch lockState {
+		case pmapper.SubAccountTypeUnlocked:
+			newBalance, err := math.Add64(accountBalance.Unlocked, amount)
+			if err != nil {
+				return nil, errUnlockedOverflow
 			}
-		case *stakeable.LockOut:
-			innerOut, ok := out.TransferableOut.(*secp256k1fx.TransferOutput)
-			switch {
-			case !ok:
-				continue utxoFor
-			case innerOut.Locktime > currentTime:
-				newBalance, err := math.Add64(accountBalance.LockedNotStakeable, out.Amount())
-				if err != nil {
-					return nil, errLockedNotStakeableOverflow
-				}
-				accountBalance.LockedNotStakeable = newBalance
-			case out.Locktime <= currentTime:
-				newBalance, err := math.Add64(accountBalance.Unlocked, out.Amount())
-				if err != nil {
-					return nil, errUnlockedOverflow
-				}
-				accountBalance.Unlocked = newBalance
-			default:
-				newBalance, err := math.Add64(accountBalance.LockedStakeable, out.Amount())
-				if err != nil {
-					return nil, errUnlockedStakeableOverflow
-				}
-				accountBalance.LockedStakeable = newBalance
+			accountBalance.Unlocked = newBalance
+		case pmapper.SubAccountTypeLockedStakeable:
+			newBalance, err := math.Add64(accountBalance.LockedStakeable, amount)
+			if err != nil {
+				return nil, errUnlockedStakeableOverflow
 			}
+			accountBalance.LockedStakeable = newBalance
 		default:
-			continue utxoFor
+			newBalance, err := math.Add64(accountBalance.LockedNotStakeable, amount)
+			if err != nil {
+				return nil, errNotStakeableOverflow
+			}
+			accountBalance.LockedNotStakeable = newBalance
 		}
 	}
 
@@ -238,6 +278,50 @@ utxoFor:
 	return accountBalance, nil
 }
 
+// getLockState returns the sub-account of the balance an output belongs to at [currentTime],
+// or false for outputs which are not counted in balances
+func getLockState(out verify.State, currentTime uint64) (string, bool) {
+	switch out := out.(type) {
+	case *secp256k1fx.TransferOutput:
+		if out.Locktime <= currentTime {
+			return pmapper.SubAccountTypeUnlocked, true
+		}
+		return pmapper.SubAccountTypeLockedNotStakeable, true
+	case *stakeable.LockOut:
+		innerOut, ok := out.TransferableOut.(*secp256k1fx.TransferOutput)
+		switch {
+		case !ok:
+			return "", false
+		case innerOut.Locktime > currentTime:
+			return pmapper.SubAccountTypeLockedNotStakeable, true
+		case out.Locktime <= currentTime:
+			return pmapper.SubAccountTypeUnlocked, true
+		default:
+			return pmapper.SubAccountTypeLockedStakeable, true
+		}
+	default:
+		return "", false
+	}
+}
+
+// filterUTXOsByLockState returns the UTXOs belonging to the [subAccount] balance at [currentTime].
+// All UTXOs are returned for sub-accounts which are not lock states.
+func filterUTXOsByLockState(utxos []avax.UTXO, subAccount string, currentTime uint64) []avax.UTXO {
+	switch subAccount {
+	case pmapper.SubAccountTypeUnlocked, pmapper.SubAccountTypeLockedStakeable, pmapper.SubAccountTypeLockedNotStakeable:
+	default:
+		return utxos
+	}
+
+	filtered := []avax.UTXO{}
+	for _, utxo := range utxos {
+		if lockState, ok := getLockState(utxo.Out, currentTime); ok && lockState == subAccount {
+			filtered = append(filtered, utxo)
+		}
+	}
+	return filtered
+}
+
 func (b *Backend) buildCurrencyAssetIDs(ctx context.Context, req *types.AccountCoinsRequest) (map[ids.ID]struct{}, *types.Error) {
 	currencyAssetIDs := make(map[ids.ID]struct{})
 	for _, reqCurrency := range req.Currencies {
@@ -258,13 +342,40 @@ func (b *Backend) buildCurrencyAssetIDs(ctx context.Context, req *types.AccountC
 //
 // Since these APIs don't return the corresponding block height or hash,
 // which is needed for both /account/balance and /account/coins, chain height is checked before and after
-// and if they differ, an error is returned.
+// and the fetch is retried if they differ.
 func (b *Backend) fetchUTXOsAndStakedOutputs(
 	ctx context.Context,
 	addr ids.ShortID,
 	fetchStaked bool,
 	fetchSharedMemory bool,
+	multisig bool,
+) (uint64, []avax.UTXO, [][]byte, *types.Error) {
+	var (
+		height          uint64
+		utxos           []avax.UTXO
+		stakedUTXOBytes [][]byte
+	)
+	typedErr := common.RetryOnChainAdvance(ctx, b.utxoFetchAttempts, func() *types.Error {
+		var typedErr *types.Error
+		height, utxos, stakedUTXOBytes, typedErr = b.fetchUTXOsAndStakedOutputsAtHeight(ctx, addr, fetchStaked, fetchSharedMemory, multisig)
+		return typedErr
+	})
+	if typedErr != nil {
+		return 0, nil, nil, typedErr
+	}
+	return height, utxos, stakedUTXOBytes, nil
+}
+
+func (b *Backend) fetchUTXOsAndStakedOutputsAtHeight(
+	ctx context.Context,
+	addr ids.ShortID,
+	fetchStaked bool,
+	fetchSharedMemory bool,
+	multisig bool,
 ) (uint64, []avax.UTXO, [][]byte, *types.Error) {
+	// read the heights and all the UTXO pages from the same node
+	ctx = client.WithPinnedNode(ctx)
+
 	// fetch preHeight before the balance fetch
 	preHeight, err := b.pClient.GetHeight(ctx)
 	if err != nil {
@@ -311,11 +422,11 @@ func (b *Backend) fetchUTXOsAndStakedOutputs(
 		return 0, nil, nil, service.WrapError(service.ErrInvalidInput, "unable to get postHeight")
 	}
 	if postHeight != preHeight {
-		return 0, nil, nil, service.WrapError(service.ErrInternalError, "new block added while fetching utxos")
+		return 0, nil, nil, service.WrapError(service.ErrChainAdvanced, "new block added while fetching utxos")
 	}
 
 	// parse UTXO bytes to UTXO structs
-	utxos, err := b.parseUTXOs(utxoBytes)
+	utxos, err := b.parseUTXOs(utxoBytes, multisig)
 	if err != nil {
 		return 0, nil, nil, service.WrapError(service.ErrInternalError, err)
 	}
@@ -355,7 +466,9 @@ func (b *Backend) calculateStakedAmount(stakeUTXOs [][]byte) (uint64, error) {
 	return staked, nil
 }
 
-func (b *Backend) parseUTXOs(utxoBytes [][]byte) ([]avax.UTXO, error) {
+// parseUTXOs returns the UTXOs having a single owner or, when [multisig] is set,
+// the UTXOs having several owners
+func (b *Backend) parseUTXOs(utxoBytes [][]byte, multisig bool) ([]avax.UTXO, error) {
 	utxos := []avax.UTXO{}
 
 	// when results are paginated, duplicate UTXOs may be provided. guarantee uniqueness
@@ -373,12 +486,11 @@ func (b *Backend) parseUTXOs(utxoBytes [][]byte) ([]avax.UTXO, error) {
 
 		utxoIDs[utxo.UTXOID.String()] = struct{}{}
 
-		// Skip multisig UTXOs
 		addressable, ok := utxo.Out.(avax.Addressable)
 		if !ok {
 			return nil, errUnableToGetUTXOOut
 		}
-		if len(addressable.Addresses()) > 1 {
+		if (len(addressable.Addresses()) > 1) != multisig {
 			continue
 		}
 
@@ -447,3 +559,65 @@ func (b *Backend) processUtxos(currencyAssetIDs map[ids.ID]struct{}, utxos []ava
 	}
 	return coins, nil
 }
+
+// buildCoinsMetadata returns the /account/coins response metadata describing the outputs of [coins]
+func buildCoinsMetadata(coins []*types.Coin, utxos []avax.UTXO, hrp string) (map[string]interface{}, error) {
+	coinIDs := make(map[string]struct{}, len(coins))
+	for _, coin := range coins {
+		coinIDs[coin.CoinIdentifier.Identifier] = struct{}{}
+	}
+
+	metadata := &pmapper.CoinsMetadata{Coins: map[string]*pmapper.CoinMetadata{}}
+	for _, utxo := range utxos {
+		coinID := utxo.UTXOID.String()
+		if _, ok := coinIDs[coinID]; !ok {
+			continue
+		}
+
+		coinMetadata, err := buildCoinMetadata(utxo, hrp)
+		if err != nil {
+			return nil, err
+		}
+		if coinMetadata != nil {
+			metadata.Coins[coinID] = coinMetadata
+		}
+	}
+
+	if len(metadata.Coins) == 0 {
+		return nil, nil
+	}
+	return mapper.MarshalJSONMap(metadata)
+}
+
+// buildCoinMetadata returns the type, locktimes and owners of the output of a coin,
+// or nil for outputs of unknown types
+func buildCoinMetadata(utxo avax.UTXO, hrp string) (*pmapper.CoinMetadata, error) {
+	metadata := &pmapper.CoinMetadata{Type: pmapper.CoinTypeTransferOutput}
+
+	out := utxo.Out
+	if lockOut, ok := out.(*stakeable.LockOut); ok {
+		metadata.Type = pmapper.CoinTypeStakeableLockOutput
+		metadata.StakeableLocktime = lockOut.Locktime
+		out = lockOut.TransferableOut
+	}
+
+	transferOut, ok := out.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return nil, nil
+	}
+	metadata.Threshold = transferOut.Threshold
+	metadata.Locktime = transferOut.Locktime
+
+	if len(transferOut.Addrs) < 2 {
+		return metadata, nil
+	}
+	for _, addr := range transferOut.Addrs {
+		owner, err := address.Format(mapper.PChainNetworkIdentifier, hrp, addr[:])
+		if err != nil {
+			return nil, err
+		}
+		metadata.Owners = append(metadata.Owners, owner)
+	}
+
+	return metadata, nil
+}
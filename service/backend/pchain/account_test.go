@@ -3,19 +3,24 @@ package pchain
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/vms/avm"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/ava-labs/avalanche-rosetta/mapper"
 	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
 	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
 	idxmocks "github.com/ava-labs/avalanche-rosetta/mocks/service/backend/pchain/indexer"
+	"github.com/ava-labs/avalanche-rosetta/service"
 	"github.com/ava-labs/avalanche-rosetta/service/backend/pchain/indexer"
 )
 
@@ -52,15 +57,15 @@ func TestAccountBalance(t *testing.T) {
 		stakeUtxoBytes := makeStakeUtxoBytes(t, backend, utxos[1].amount)
 
 		// once before other calls, once after
-		pChainMock.Mock.On("GetHeight", ctx).Return(blockHeight, nil).Twice()
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight, nil).Twice()
 		// Make sure pagination works as well
 		pageSize := uint32(2)
 		backend.getUTXOsPageSize = pageSize
-		pChainMock.Mock.On("GetAtomicUTXOs", ctx, []ids.ShortID{addr}, "", pageSize, ids.ShortEmpty, ids.Empty).
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{addr}, "", pageSize, ids.ShortEmpty, ids.Empty).
 			Return([][]byte{utxo0Bytes, utxo1Bytes}, addr, utxo1Id, nil).Once()
-		pChainMock.Mock.On("GetAtomicUTXOs", ctx, []ids.ShortID{addr}, "", pageSize, addr, utxo1Id).
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{addr}, "", pageSize, addr, utxo1Id).
 			Return([][]byte{utxo1Bytes}, addr, utxo1Id, nil).Once()
-		pChainMock.Mock.On("GetStake", ctx, []ids.ShortID{addr}).Return(map[ids.ID]uint64{}, [][]byte{stakeUtxoBytes}, nil).Once()
+		pChainMock.Mock.On("GetStake", mock.Anything, []ids.ShortID{addr}).Return(map[ids.ID]uint64{}, [][]byte{stakeUtxoBytes}, nil).Once()
 
 		resp, err := backend.AccountBalance(
 			ctx,
@@ -104,12 +109,12 @@ func TestAccountBalance(t *testing.T) {
 		assert.Nil(t, errp)
 
 		// once before other calls, once after
-		pChainMock.Mock.On("GetHeight", ctx).Return(blockHeight, nil).Twice()
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight, nil).Twice()
 		pageSize := uint32(1024)
 		backend.getUTXOsPageSize = pageSize
-		pChainMock.Mock.On("GetAtomicUTXOs", ctx, []ids.ShortID{pChainAddrID}, "C", pageSize, ids.ShortEmpty, ids.Empty).
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{pChainAddrID}, "C", pageSize, ids.ShortEmpty, ids.Empty).
 			Return([][]byte{utxo0Bytes, utxo1Bytes}, pChainAddrID, utxo1Id, nil).Once()
-		pChainMock.Mock.On("GetAtomicUTXOs", ctx, []ids.ShortID{pChainAddrID}, "X", pageSize, ids.ShortEmpty, ids.Empty).
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{pChainAddrID}, "X", pageSize, ids.ShortEmpty, ids.Empty).
 			Return([][]byte{}, pChainAddrID, ids.Empty, nil).Once()
 
 		resp, err := backend.AccountBalance(
@@ -147,38 +152,60 @@ func TestAccountBalance(t *testing.T) {
 		parserMock.AssertExpectations(t)
 	})
 
-	t.Run("Account Balance should error if new block was added while fetching UTXOs", func(t *testing.T) {
+	balanceRequest := &types.AccountBalanceRequest{
+		NetworkIdentifier: &types.NetworkIdentifier{
+			Network: mapper.FujiNetwork,
+			SubNetworkIdentifier: &types.SubNetworkIdentifier{
+				Network: mapper.PChainNetworkIdentifier,
+			},
+		},
+		AccountIdentifier: &types.AccountIdentifier{
+			Address: pChainAddr,
+		},
+		Currencies: []*types.Currency{
+			mapper.AtomicAvaxCurrency,
+		},
+	}
+
+	t.Run("Account Balance should retry if new block was added while fetching UTXOs", func(t *testing.T) {
 		addr, _ := address.ParseToID(pChainAddr)
 
 		pageSize := uint32(2)
 		backend.getUTXOsPageSize = pageSize
-		pChainMock.Mock.On("GetHeight", ctx).Return(blockHeight, nil).Once()
-		pChainMock.Mock.On("GetAtomicUTXOs", ctx, []ids.ShortID{addr}, "", pageSize, ids.ShortEmpty, ids.Empty).
-			Return([][]byte{}, addr, ids.Empty, nil).Once()
-		pChainMock.Mock.On("GetStake", ctx, []ids.ShortID{addr}).Return(map[ids.ID]uint64{}, [][]byte{}, nil)
-		// return blockHeight + 1 to indicate a new block arrival
-		pChainMock.Mock.On("GetHeight", ctx).Return(blockHeight+1, nil).Once()
+		backend.SetUTXOFetchAttempts(2)
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{addr}, "", pageSize, ids.ShortEmpty, ids.Empty).
+			Return([][]byte{}, addr, ids.Empty, nil).Twice()
+		pChainMock.Mock.On("GetStake", mock.Anything, []ids.ShortID{addr}).Return(map[ids.ID]uint64{}, [][]byte{}, nil)
+		// return blockHeight + 1 to indicate a new block arrival during the first attempt only
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight-1, nil).Once()
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight, nil).Times(3)
 
-		resp, err := backend.AccountBalance(
-			ctx,
-			&types.AccountBalanceRequest{
-				NetworkIdentifier: &types.NetworkIdentifier{
-					Network: mapper.FujiNetwork,
-					SubNetworkIdentifier: &types.SubNetworkIdentifier{
-						Network: mapper.PChainNetworkIdentifier,
-					},
-				},
-				AccountIdentifier: &types.AccountIdentifier{
-					Address: pChainAddr,
-				},
-				Currencies: []*types.Currency{
-					mapper.AtomicAvaxCurrency,
-				},
-			},
-		)
+		resp, err := backend.AccountBalance(ctx, balanceRequest)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(blockHeight), resp.BlockIdentifier.Index)
+		pChainMock.AssertExpectations(t)
+		parserMock.AssertExpectations(t)
+	})
+
+	t.Run("Account Balance should error if new blocks kept being added while fetching UTXOs", func(t *testing.T) {
+		addr, _ := address.ParseToID(pChainAddr)
+
+		pageSize := uint32(2)
+		backend.getUTXOsPageSize = pageSize
+		backend.SetUTXOFetchAttempts(2)
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{addr}, "", pageSize, ids.ShortEmpty, ids.Empty).
+			Return([][]byte{}, addr, ids.Empty, nil).Twice()
+		pChainMock.Mock.On("GetStake", mock.Anything, []ids.ShortID{addr}).Return(map[ids.ID]uint64{}, [][]byte{}, nil)
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight, nil).Once()
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight+1, nil).Twice()
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight+2, nil).Once()
+
+		resp, err := backend.AccountBalance(ctx, balanceRequest)
 
 		assert.Nil(t, resp)
-		assert.Equal(t, "Internal server error", err.Message)
+		assert.Equal(t, service.ErrChainAdvanced.Code, err.Code)
+		assert.True(t, err.Retriable)
 		assert.Equal(t, "new block added while fetching utxos", err.Details["error"])
 		pChainMock.AssertExpectations(t)
 		parserMock.AssertExpectations(t)
@@ -209,13 +236,13 @@ func TestAccountCoins(t *testing.T) {
 		assert.Nil(t, errp)
 
 		// once before other calls, once after
-		pChainMock.Mock.On("GetHeight", ctx).Return(blockHeight, nil).Twice()
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight, nil).Twice()
 		// Make sure pagination works as well
 		pageSize := uint32(2)
 		backend.getUTXOsPageSize = pageSize
-		pChainMock.Mock.On("GetAtomicUTXOs", ctx, []ids.ShortID{pChainAddrID}, "", pageSize, ids.ShortEmpty, ids.Empty).
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{pChainAddrID}, "", pageSize, ids.ShortEmpty, ids.Empty).
 			Return([][]byte{utxo0Bytes, utxo1Bytes}, pChainAddrID, utxo1Id, nil).Once()
-		pChainMock.Mock.On("GetAtomicUTXOs", ctx, []ids.ShortID{pChainAddrID}, "", pageSize, pChainAddrID, utxo1Id).
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{pChainAddrID}, "", pageSize, pChainAddrID, utxo1Id).
 			Return([][]byte{utxo1Bytes}, pChainAddrID, utxo1Id, nil).Once()
 
 		resp, err := backend.AccountCoins(
@@ -260,6 +287,7 @@ func TestAccountCoins(t *testing.T) {
 					},
 				},
 			},
+			Metadata: transferCoinsMetadata(utxos[0].id, utxos[1].id),
 		}
 
 		assert.Nil(t, err)
@@ -286,12 +314,12 @@ func TestAccountCoins(t *testing.T) {
 		assert.Nil(t, errp)
 
 		// once before other calls, once after
-		pChainMock.Mock.On("GetHeight", ctx).Return(blockHeight, nil).Twice()
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight, nil).Twice()
 		pageSize := uint32(1024)
 		backend.getUTXOsPageSize = pageSize
-		pChainMock.Mock.On("GetAtomicUTXOs", ctx, []ids.ShortID{pChainAddrID}, "C", pageSize, ids.ShortEmpty, ids.Empty).
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{pChainAddrID}, "C", pageSize, ids.ShortEmpty, ids.Empty).
 			Return([][]byte{utxo0Bytes}, pChainAddrID, utxo0Id, nil).Once()
-		pChainMock.Mock.On("GetAtomicUTXOs", ctx, []ids.ShortID{pChainAddrID}, "X", pageSize, ids.ShortEmpty, ids.Empty).
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{pChainAddrID}, "X", pageSize, ids.ShortEmpty, ids.Empty).
 			Return([][]byte{utxo1Bytes}, pChainAddrID, utxo1Id, nil).Once()
 
 		resp, err := backend.AccountCoins(
@@ -337,6 +365,7 @@ func TestAccountCoins(t *testing.T) {
 					},
 				},
 			},
+			Metadata: transferCoinsMetadata(utxos[0].id, utxos[1].id),
 		}
 
 		assert.Nil(t, err)
@@ -346,6 +375,215 @@ func TestAccountCoins(t *testing.T) {
 	})
 }
 
+func TestAccountCoinsLockState(t *testing.T) {
+	ctx := context.Background()
+	pChainMock := &mocks.PChainClient{}
+	parserMock := &idxmocks.Parser{}
+	parserMock.Mock.On("ParseBlockAtIndex", ctx, blockHeight).Return(parsedBlock, nil)
+	backend := NewBackend(pChainMock, parserMock, avaxAssetID, nil)
+	backend.getUTXOsPageSize = 1024
+
+	pChainAddrID, err := address.ParseToID(pChainAddr)
+	assert.Nil(t, err)
+
+	stakeableLocktime := uint64(time.Now().Add(time.Hour).Unix())
+	utxo0Bytes := makeUtxoBytes(t, backend, utxos[0].id, utxos[0].amount)
+	utxo1ID, err := mapper.DecodeUTXOID(utxos[1].id)
+	assert.Nil(t, err)
+	utxo1Bytes, err := backend.codec.Marshal(0, &avax.UTXO{
+		UTXOID: *utxo1ID,
+		Out: &stakeable.LockOut{
+			Locktime:        stakeableLocktime,
+			TransferableOut: &secp256k1fx.TransferOutput{Amt: utxos[1].amount},
+		},
+	})
+	assert.Nil(t, err)
+
+	networkIdentifier := &types.NetworkIdentifier{
+		Network: mapper.FujiNetwork,
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{
+			Network: mapper.PChainNetworkIdentifier,
+		},
+	}
+
+	tests := map[string]struct {
+		subAccount    string
+		expectedCoins []string
+	}{
+		"unlocked coins":             {pmapper.SubAccountTypeUnlocked, []string{utxos[0].id}},
+		"locked stakeable coins":     {pmapper.SubAccountTypeLockedStakeable, []string{utxos[1].id}},
+		"locked not stakeable coins": {pmapper.SubAccountTypeLockedNotStakeable, []string{}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// once before other calls, once after
+			pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight, nil).Twice()
+			pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{pChainAddrID}, "", uint32(1024), ids.ShortEmpty, ids.Empty).
+				Return([][]byte{utxo0Bytes, utxo1Bytes}, pChainAddrID, ids.Empty, nil).Once()
+
+			resp, err := backend.AccountCoins(ctx, &types.AccountCoinsRequest{
+				NetworkIdentifier: networkIdentifier,
+				AccountIdentifier: &types.AccountIdentifier{
+					Address:    pChainAddr,
+					SubAccount: &types.SubAccountIdentifier{Address: test.subAccount},
+				},
+			})
+			assert.Nil(t, err)
+
+			coinIDs := []string{}
+			for _, coin := range resp.Coins {
+				coinIDs = append(coinIDs, coin.CoinIdentifier.Identifier)
+			}
+			assert.Equal(t, test.expectedCoins, coinIDs)
+			pChainMock.AssertExpectations(t)
+		})
+	}
+
+	t.Run("locked coins carry their locktimes", func(t *testing.T) {
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight, nil).Twice()
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{pChainAddrID}, "", uint32(1024), ids.ShortEmpty, ids.Empty).
+			Return([][]byte{utxo0Bytes, utxo1Bytes}, pChainAddrID, ids.Empty, nil).Once()
+
+		resp, err := backend.AccountCoins(ctx, &types.AccountCoinsRequest{
+			NetworkIdentifier: networkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{Address: pChainAddr},
+		})
+		assert.Nil(t, err)
+
+		var metadata pmapper.CoinsMetadata
+		assert.Nil(t, mapper.UnmarshalJSONMap(resp.Metadata, &metadata))
+		assert.Equal(t, map[string]*pmapper.CoinMetadata{
+			utxos[0].id: {Type: pmapper.CoinTypeTransferOutput},
+			utxos[1].id: {Type: pmapper.CoinTypeStakeableLockOutput, StakeableLocktime: stakeableLocktime},
+		}, metadata.Coins)
+	})
+
+	t.Run("staked coins are not supported", func(t *testing.T) {
+		_, err := backend.AccountCoins(ctx, &types.AccountCoinsRequest{
+			NetworkIdentifier: networkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address:    pChainAddr,
+				SubAccount: &types.SubAccountIdentifier{Address: pmapper.SubAccountTypeStaked},
+			},
+		})
+		assert.Equal(t, service.ErrInvalidInput.Code, err.Code)
+	})
+}
+
+func TestAccountMultisig(t *testing.T) {
+	ctx := context.Background()
+	pChainMock := &mocks.PChainClient{}
+	parserMock := &idxmocks.Parser{}
+	parserMock.Mock.On("ParseBlockAtIndex", ctx, blockHeight).Return(parsedBlock, nil)
+	backend := NewBackend(pChainMock, parserMock, avaxAssetID, nil)
+
+	networkIdentifier := &types.NetworkIdentifier{
+		Network: mapper.FujiNetwork,
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{
+			Network: mapper.PChainNetworkIdentifier,
+		},
+	}
+	accountIdentifier := &types.AccountIdentifier{
+		Address:    pChainAddr,
+		SubAccount: &types.SubAccountIdentifier{Address: pmapper.SubAccountTypeMultisig},
+	}
+
+	pChainAddrID, err := address.ParseToID(pChainAddr)
+	assert.Nil(t, err)
+	coOwnerID := ids.ShortID{1}
+	owners := []ids.ShortID{pChainAddrID, coOwnerID}
+	ids.SortShortIDs(owners)
+
+	utxo0Bytes := makeUtxoBytes(t, backend, utxos[0].id, utxos[0].amount)
+	utxo1Bytes := makeMultisigUtxoBytes(t, backend, utxos[1].id, utxos[1].amount, owners)
+	mockUTXOs := func() {
+		// once before other calls, once after
+		pChainMock.Mock.On("GetHeight", mock.Anything).Return(blockHeight, nil).Twice()
+		pageSize := uint32(1024)
+		backend.getUTXOsPageSize = pageSize
+		pChainMock.Mock.On("GetAtomicUTXOs", mock.Anything, []ids.ShortID{pChainAddrID}, "", pageSize, ids.ShortEmpty, ids.Empty).
+			Return([][]byte{utxo0Bytes, utxo1Bytes}, pChainAddrID, ids.Empty, nil).Once()
+	}
+
+	t.Run("Account Balance should return total of multisig UTXOs", func(t *testing.T) {
+		mockUTXOs()
+
+		resp, err := backend.AccountBalance(ctx, &types.AccountBalanceRequest{
+			NetworkIdentifier: networkIdentifier,
+			AccountIdentifier: accountIdentifier,
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Amount{{
+			Value:    "2000000000",
+			Currency: mapper.AtomicAvaxCurrency,
+		}}, resp.Balances)
+		pChainMock.AssertExpectations(t)
+	})
+
+	t.Run("Account Coins should return multisig coins with their owners", func(t *testing.T) {
+		mockUTXOs()
+
+		resp, err := backend.AccountCoins(ctx, &types.AccountCoinsRequest{
+			NetworkIdentifier: networkIdentifier,
+			AccountIdentifier: accountIdentifier,
+		})
+		assert.Nil(t, err)
+
+		assert.Equal(t, []*types.Coin{{
+			CoinIdentifier: &types.CoinIdentifier{Identifier: utxos[1].id},
+			Amount: &types.Amount{
+				Value:    "2000000000",
+				Currency: mapper.AtomicAvaxCurrency,
+			},
+		}}, resp.Coins)
+
+		var metadata pmapper.CoinsMetadata
+		assert.Nil(t, mapper.UnmarshalJSONMap(resp.Metadata, &metadata))
+		expectedOwners := []string{}
+		for _, owner := range owners {
+			addr, err := address.Format(mapper.PChainNetworkIdentifier, constants.FujiHRP, owner[:])
+			assert.Nil(t, err)
+			expectedOwners = append(expectedOwners, addr)
+		}
+		assert.Equal(t, map[string]*pmapper.CoinMetadata{
+			utxos[1].id: {
+				Type:      pmapper.CoinTypeTransferOutput,
+				Owners:    expectedOwners,
+				Threshold: 1,
+			},
+		}, metadata.Coins)
+		pChainMock.AssertExpectations(t)
+	})
+
+	t.Run("Account Coins should not return multisig coins of past blocks", func(t *testing.T) {
+		_, err := backend.AccountCoins(ctx, &types.AccountCoinsRequest{
+			NetworkIdentifier: networkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address:    pChainAddr,
+				SubAccount: accountIdentifier.SubAccount,
+				Metadata: map[string]interface{}{
+					"block_identifier": map[string]interface{}{"index": 1},
+				},
+			},
+		})
+		assert.Equal(t, service.ErrNotSupported.Code, err.Code)
+	})
+}
+
+// transferCoinsMetadata returns the /account/coins metadata of unlocked transfer outputs made by makeUtxoBytes
+func transferCoinsMetadata(coinIDs ...string) map[string]interface{} {
+	coins := map[string]interface{}{}
+	for _, coinID := range coinIDs {
+		coins[coinID] = map[string]interface{}{
+			"type":      pmapper.CoinTypeTransferOutput,
+			"threshold": 0.0,
+			"locktime":  0.0,
+		}
+	}
+	return map[string]interface{}{"coins": coins}
+}
+
 func makeUtxoBytes(t *testing.T, backend *Backend, utxoIDStr string, amount uint64) []byte {
 	utxoID, err := mapper.DecodeUTXOID(utxoIDStr)
 	if err != nil {
@@ -364,6 +602,30 @@ func makeUtxoBytes(t *testing.T, backend *Backend, utxoIDStr string, amount uint
 	return utxoBytes
 }
 
+func makeMultisigUtxoBytes(t *testing.T, backend *Backend, utxoIDStr string, amount uint64, owners []ids.ShortID) []byte {
+	utxoID, err := mapper.DecodeUTXOID(utxoIDStr)
+	if err != nil {
+		t.Fail()
+		return nil
+	}
+
+	utxoBytes, err := backend.codec.Marshal(0, &avax.UTXO{
+		UTXOID: *utxoID,
+		Out: &secp256k1fx.TransferOutput{
+			Amt: amount,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     owners,
+			},
+		},
+	})
+	if err != nil {
+		t.Fail()
+	}
+
+	return utxoBytes
+}
+
 func makeStakeUtxoBytes(t *testing.T, backend *Backend, amount uint64) []byte {
 	utxoBytes, err := backend.codec.Marshal(0, &avax.TransferableOutput{
 		Out: &secp256k1fx.TransferOutput{Amt: amount},
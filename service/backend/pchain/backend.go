@@ -2,6 +2,7 @@ package pchain
 
 import (
 	"context"
+	"sync"
 
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
@@ -13,7 +14,9 @@ import (
 	"github.com/ava-labs/avalanche-rosetta/client"
 	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
 	"github.com/ava-labs/avalanche-rosetta/service"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/common"
 	"github.com/ava-labs/avalanche-rosetta/service/backend/pchain/indexer"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/pchain/utxoindex"
 )
 
 var (
@@ -21,6 +24,8 @@ var (
 	_ service.NetworkBackend      = &Backend{}
 	_ service.AccountBackend      = &Backend{}
 	_ service.BlockBackend        = &Backend{}
+	_ service.MempoolBackend      = &Backend{}
+	_ service.CallBackend         = &Backend{}
 )
 
 type Backend struct {
@@ -29,12 +34,24 @@ type Backend struct {
 	pClient                client.PChainClient
 	indexerParser          indexer.Parser
 	getUTXOsPageSize       uint32
+	utxoFetchAttempts      int
 	codec                  codec.Manager
 	codecVersion           uint16
 	genesisBlock           *indexer.ParsedGenesisBlock
 	genesisBlockIdentifier *types.BlockIdentifier
 	chainIDs               map[string]string
 	avaxAssetID            ids.ID
+
+	// utxoIndex is used for historical lookups, when enabled
+	utxoIndex *utxoindex.Index
+
+	// blockCache holds the blocks served so far, when enabled
+	blockCache *service.BlockCache
+
+	// issuedTxs holds the transactions submitted through this backend
+	// which are not yet known to be decided
+	issuedTxsLock sync.Mutex
+	issuedTxs     map[ids.ID]*issuedTx
 }
 
 func NewBackend(
@@ -48,13 +65,27 @@ func NewBackend(
 		networkIdentifier: networkIdentifier,
 		pClient:           pClient,
 		getUTXOsPageSize:  1024,
+		utxoFetchAttempts: common.DefaultUTXOFetchAttempts,
 		codec:             blocks.Codec,
 		codecVersion:      txs.Version,
 		indexerParser:     indexerParser,
 		avaxAssetID:       assetID,
+		issuedTxs:         make(map[ids.ID]*issuedTx),
 	}
 }
 
+// SetUTXOFetchAttempts sets the number of times UTXOs are fetched when the chain
+// keeps advancing during the fetch
+func (b *Backend) SetUTXOFetchAttempts(attempts int) {
+	b.utxoFetchAttempts = attempts
+}
+
+// SetBlockCache makes the backend serve /block and /block/transaction requests
+// from [blockCache], which is filled with the blocks served
+func (b *Backend) SetBlockCache(blockCache *service.BlockCache) {
+	b.blockCache = blockCache
+}
+
 func (b *Backend) ShouldHandleRequest(req interface{}) bool {
 	switch r := req.(type) {
 	case *types.AccountBalanceRequest:
@@ -83,6 +114,10 @@ func (b *Backend) ShouldHandleRequest(req interface{}) bool {
 		return pmapper.IsPChain(r.NetworkIdentifier)
 	case *types.NetworkRequest:
 		return pmapper.IsPChain(r.NetworkIdentifier)
+	case *types.MempoolTransactionRequest:
+		return pmapper.IsPChain(r.NetworkIdentifier)
+	case *types.CallRequest:
+		return pmapper.IsPChain(r.NetworkIdentifier)
 	}
 
 	return false
@@ -53,6 +53,8 @@ func TestShouldHandleRequest(t *testing.T) {
 				&types.BlockRequest{NetworkIdentifier: tc.networkIdentifier},
 				&types.BlockTransactionRequest{NetworkIdentifier: tc.networkIdentifier},
 				&types.NetworkRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.MempoolTransactionRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.CallRequest{NetworkIdentifier: tc.networkIdentifier},
 			}
 			for _, r := range requests {
 				assert.Equal(t, tc.expected, backend.ShouldHandleRequest(r))
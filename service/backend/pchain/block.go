@@ -31,6 +31,12 @@ var (
 
 // Block implements the /block endpoint
 func (b *Backend) Block(ctx context.Context, request *types.BlockRequest) (*types.BlockResponse, *types.Error) {
+	if block, ok := b.blockCache.Get(request.BlockIdentifier); ok {
+		return &types.BlockResponse{
+			Block: block,
+		}, nil
+	}
+
 	var blockIndex int64
 	var hash string
 
@@ -53,7 +59,7 @@ func (b *Backend) Block(ctx context.Context, request *types.BlockRequest) (*type
 			return nil, service.WrapError(service.ErrClientError, err)
 		}
 
-		return &types.BlockResponse{
+		resp := &types.BlockResponse{
 			Block: &types.Block{
 				BlockIdentifier: b.genesisBlockIdentifier,
 				// Parent block identifier of genesis block is set to itself instead of the hash of the genesis state
@@ -66,7 +72,10 @@ func (b *Backend) Block(ctx context.Context, request *types.BlockRequest) (*type
 					pmapper.MetadataMessage: genesisBlock.Message,
 				},
 			},
-		}, nil
+		}
+		b.blockCache.Put(resp.Block)
+
+		return resp, nil
 	}
 
 	block, err := b.getBlockDetails(ctx, blockIndex, hash)
@@ -94,12 +103,20 @@ func (b *Backend) Block(ctx context.Context, request *types.BlockRequest) (*type
 			Transactions: transactions,
 		},
 	}
+	// Blocks are read from the index of accepted blocks, so they are final
+	b.blockCache.Put(resp.Block)
 
 	return resp, nil
 }
 
 // BlockTransaction implements the /block/transaction endpoint.
 func (b *Backend) BlockTransaction(ctx context.Context, request *types.BlockTransactionRequest) (*types.BlockTransactionResponse, *types.Error) {
+	if transaction, ok := b.blockCache.GetTransaction(request.BlockIdentifier, request.TransactionIdentifier.Hash); ok {
+		return &types.BlockTransactionResponse{
+			Transaction: transaction,
+		}, nil
+	}
+
 	isGenesisBlockRequest, err := b.isGenesisBlockRequest(ctx, request.BlockIdentifier.Index, request.BlockIdentifier.Hash)
 	if err != nil {
 		return nil, service.WrapError(service.ErrClientError, err)
@@ -397,7 +414,8 @@ func (b *Backend) buildGenesisAllocationTx() (*txs.Tx, error) {
 	outs := []*avax.TransferableOutput{}
 	for _, utxo := range b.genesisBlock.UTXOs {
 		outIntf := utxo.Out
-		if lockedOut, ok := outIntf.(*stakeable.LockOut); ok {
+		lockedOut, isLocked := outIntf.(*stakeable.LockOut)
+		if isLocked {
 			outIntf = lockedOut.TransferableOut
 		}
 
@@ -407,15 +425,25 @@ func (b *Backend) buildGenesisAllocationTx() (*txs.Tx, error) {
 			return nil, errUnableToParseUTXO
 		}
 
-		outs = append(outs, &avax.TransferableOutput{
-			Out: &secp256k1fx.TransferOutput{
-				Amt: out.Amount(),
-				OutputOwners: secp256k1fx.OutputOwners{
-					Addrs:     out.Addrs,
-					Threshold: out.Threshold,
-					Locktime:  out.Locktime,
-				},
+		var transferOut avax.TransferableOut = &secp256k1fx.TransferOutput{
+			Amt: out.Amount(),
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs:     out.Addrs,
+				Threshold: out.Threshold,
+				Locktime:  out.Locktime,
 			},
+		}
+
+		// Stakeable locks are kept so that the parsed operations carry the stakeable locktime
+		if isLocked {
+			transferOut = &stakeable.LockOut{
+				Locktime:        lockedOut.Locktime,
+				TransferableOut: transferOut,
+			}
+		}
+
+		outs = append(outs, &avax.TransferableOutput{
+			Out: transferOut,
 		})
 	}
 
@@ -0,0 +1,447 @@
+package pchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+var (
+	errUnknownStakingTx   = errors.New("transaction is not a staking transaction")
+	errUnknownRewardOwner = errors.New("unknown reward owner type")
+)
+
+// validatorTx is implemented by the transactions adding a Primary Network validator
+type validatorTx interface {
+	ValidationRewardsOwner() fx.Owner
+	DelegationRewardsOwner() fx.Owner
+}
+
+// delegatorTx is implemented by the transactions adding a Primary Network delegator
+type delegatorTx interface {
+	RewardsOwner() fx.Owner
+}
+
+// Call implements /call endpoint
+//
+// Supported methods are platform_getStakingPositions, returning the stake of a
+// set of addresses and the current and pending validations and delegations
+// rewarding them, and platform_getStakingRewards, returning the reward UTXOs
+// of ended staking transactions.
+func (b *Backend) Call(ctx context.Context, req *types.CallRequest) (*types.CallResponse, *types.Error) {
+	switch req.Method {
+	case pmapper.CallGetStakingPositions:
+		return b.callGetStakingPositions(ctx, req)
+	case pmapper.CallGetStakingRewards:
+		return b.callGetStakingRewards(ctx, req)
+	default:
+		return nil, service.ErrCallInvalidMethod
+	}
+}
+
+func (b *Backend) callGetStakingPositions(ctx context.Context, req *types.CallRequest) (*types.CallResponse, *types.Error) {
+	var input pmapper.GetStakingPositionsInput
+	if err := types.UnmarshalMap(req.Parameters, &input); err != nil {
+		return nil, service.WrapError(service.ErrCallInvalidParams, err)
+	}
+	if len(input.Addresses) == 0 {
+		return nil, service.WrapError(service.ErrCallInvalidParams, "addresses missing from params")
+	}
+
+	hrp, err := mapper.GetHRP(req.NetworkIdentifier)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, "incorrect network identifier")
+	}
+
+	addrs := make([]ids.ShortID, 0, len(input.Addresses))
+	addrSet := ids.ShortSet{}
+	for _, addrString := range input.Addresses {
+		addr, err := address.ParseToID(addrString)
+		if err != nil {
+			return nil, service.WrapError(service.ErrCallInvalidParams, err)
+		}
+		addrs = append(addrs, addr)
+		addrSet.Add(addr)
+	}
+
+	stake, _, err := b.pClient.GetStake(ctx, addrs)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+
+	output := &pmapper.GetStakingPositionsOutput{
+		Staked:    strconv.FormatUint(stake[b.avaxAssetID], 10),
+		Positions: []*pmapper.StakingPosition{},
+	}
+
+	currentPositions, err := b.getCurrentStakingPositions(ctx, addrSet, hrp)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+	output.Positions = append(output.Positions, currentPositions...)
+
+	pendingPositions, err := b.getPendingStakingPositions(ctx, addrSet, hrp)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+	output.Positions = append(output.Positions, pendingPositions...)
+
+	result, err := mapper.MarshalJSONMap(output)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+	return &types.CallResponse{Result: result}, nil
+}
+
+// getCurrentStakingPositions returns the current Primary Network validations and
+// delegations whose rewards are owned by one of [addrs]
+func (b *Backend) getCurrentStakingPositions(
+	ctx context.Context,
+	addrs ids.ShortSet,
+	hrp string,
+) ([]*pmapper.StakingPosition, error) {
+	validators, err := b.pClient.GetCurrentValidators(ctx, constants.PrimaryNetworkID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := []*pmapper.StakingPosition{}
+	for _, validator := range validators {
+		if ownsRewards(addrs, validator.ValidationRewardOwner) || ownsRewards(addrs, validator.DelegationRewardOwner) {
+			position, err := newStakingPosition(
+				validator.ClientStaker,
+				pmapper.StakingPositionValidator,
+				pmapper.StakingStatusCurrent,
+				validator.ValidationRewardOwner,
+				validator.DelegationRewardOwner,
+				hrp,
+			)
+			if err != nil {
+				return nil, err
+			}
+			position.DelegationFee = validator.DelegationFee
+			if validator.PotentialReward != nil {
+				position.PotentialReward = strconv.FormatUint(*validator.PotentialReward, 10)
+			}
+			positions = append(positions, position)
+		}
+
+		for _, delegator := range validator.Delegators {
+			if !ownsRewards(addrs, delegator.RewardOwner) {
+				continue
+			}
+			position, err := newStakingPosition(
+				delegator.ClientStaker,
+				pmapper.StakingPositionDelegator,
+				pmapper.StakingStatusCurrent,
+				delegator.RewardOwner,
+				nil,
+				hrp,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if delegator.PotentialReward != nil {
+				position.PotentialReward = strconv.FormatUint(*delegator.PotentialReward, 10)
+			}
+			positions = append(positions, position)
+		}
+	}
+
+	return positions, nil
+}
+
+// getPendingStakingPositions returns the pending Primary Network validations and
+// delegations whose rewards are owned by one of [addrs].
+//
+// platform.getPendingValidators does not report reward owners, which are
+// read from the staking transactions instead.
+func (b *Backend) getPendingStakingPositions(
+	ctx context.Context,
+	addrs ids.ShortSet,
+	hrp string,
+) ([]*pmapper.StakingPosition, error) {
+	validators, delegators, err := b.pClient.GetPendingValidators(ctx, constants.PrimaryNetworkID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := []*pmapper.StakingPosition{}
+	for _, v := range validators {
+		var validator platformapi.PermissionlessValidator
+		if err := decodeStaker(v, &validator); err != nil {
+			return nil, err
+		}
+
+		tx, err := b.getStakingTx(ctx, validator.TxID)
+		if err != nil {
+			return nil, err
+		}
+		stakingTx, ok := tx.Unsigned.(validatorTx)
+		if !ok {
+			return nil, errUnknownStakingTx
+		}
+		rewardOwner, err := toClientOwner(stakingTx.ValidationRewardsOwner())
+		if err != nil {
+			return nil, err
+		}
+		delegationRewardOwner, err := toClientOwner(stakingTx.DelegationRewardsOwner())
+		if err != nil {
+			return nil, err
+		}
+		if !ownsRewards(addrs, rewardOwner) && !ownsRewards(addrs, delegationRewardOwner) {
+			continue
+		}
+
+		position, err := newStakingPosition(
+			toClientStaker(validator.Staker),
+			pmapper.StakingPositionValidator,
+			pmapper.StakingStatusPending,
+			rewardOwner,
+			delegationRewardOwner,
+			hrp,
+		)
+		if err != nil {
+			return nil, err
+		}
+		position.DelegationFee = float32(validator.DelegationFee)
+		positions = append(positions, position)
+	}
+
+	for _, d := range delegators {
+		var delegator platformapi.Staker
+		if err := decodeStaker(d, &delegator); err != nil {
+			return nil, err
+		}
+
+		tx, err := b.getStakingTx(ctx, delegator.TxID)
+		if err != nil {
+			return nil, err
+		}
+		stakingTx, ok := tx.Unsigned.(delegatorTx)
+		if !ok {
+			return nil, errUnknownStakingTx
+		}
+		rewardOwner, err := toClientOwner(stakingTx.RewardsOwner())
+		if err != nil {
+			return nil, err
+		}
+		if !ownsRewards(addrs, rewardOwner) {
+			continue
+		}
+
+		position, err := newStakingPosition(
+			toClientStaker(delegator),
+			pmapper.StakingPositionDelegator,
+			pmapper.StakingStatusPending,
+			rewardOwner,
+			nil,
+			hrp,
+		)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+
+	return positions, nil
+}
+
+func (b *Backend) getStakingTx(ctx context.Context, txID ids.ID) (*txs.Tx, error) {
+	txBytes, err := b.pClient.GetTx(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx txs.Tx
+	if _, err := b.codec.Unmarshal(txBytes, &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func (b *Backend) callGetStakingRewards(ctx context.Context, req *types.CallRequest) (*types.CallResponse, *types.Error) {
+	var input pmapper.GetStakingRewardsInput
+	if err := types.UnmarshalMap(req.Parameters, &input); err != nil {
+		return nil, service.WrapError(service.ErrCallInvalidParams, err)
+	}
+	if len(input.TxIDs) == 0 {
+		return nil, service.WrapError(service.ErrCallInvalidParams, "tx_ids missing from params")
+	}
+
+	hrp, err := mapper.GetHRP(req.NetworkIdentifier)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, "incorrect network identifier")
+	}
+
+	txIDs := make([]ids.ID, 0, len(input.TxIDs))
+	for _, txIDString := range input.TxIDs {
+		txID, err := ids.FromString(txIDString)
+		if err != nil {
+			return nil, service.WrapError(service.ErrCallInvalidParams, err)
+		}
+		txIDs = append(txIDs, txID)
+	}
+
+	output := &pmapper.GetStakingRewardsOutput{Rewards: []*pmapper.StakingReward{}}
+	for _, txID := range txIDs {
+		utxoBytes, err := b.pClient.GetRewardUTXOs(ctx, &api.GetTxArgs{
+			TxID:     txID,
+			Encoding: formatting.Hex,
+		})
+		if err != nil {
+			return nil, service.WrapError(service.ErrClientError, err)
+		}
+
+		for _, bytes := range utxoBytes {
+			var utxo avax.UTXO
+			if _, err := b.codec.Unmarshal(bytes, &utxo); err != nil {
+				return nil, service.WrapError(service.ErrInternalError, err)
+			}
+
+			out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+			if !ok {
+				continue
+			}
+			owner, err := formatRewardOwner(&platformvm.ClientOwner{
+				Locktime:  out.Locktime,
+				Threshold: out.Threshold,
+				Addresses: out.Addrs,
+			}, hrp)
+			if err != nil {
+				return nil, service.WrapError(service.ErrInternalError, err)
+			}
+
+			output.Rewards = append(output.Rewards, &pmapper.StakingReward{
+				TxID:           txID.String(),
+				CoinIdentifier: utxo.UTXOID.String(),
+				Amount:         strconv.FormatUint(out.Amt, 10),
+				Owner:          owner,
+			})
+		}
+	}
+
+	result, err := mapper.MarshalJSONMap(output)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+	return &types.CallResponse{Result: result}, nil
+}
+
+func newStakingPosition(
+	staker platformvm.ClientStaker,
+	positionType string,
+	status string,
+	rewardOwner *platformvm.ClientOwner,
+	delegationRewardOwner *platformvm.ClientOwner,
+	hrp string,
+) (*pmapper.StakingPosition, error) {
+	position := &pmapper.StakingPosition{
+		TxID:      staker.TxID.String(),
+		Type:      positionType,
+		Status:    status,
+		NodeID:    staker.NodeID.String(),
+		StartTime: staker.StartTime,
+		EndTime:   staker.EndTime,
+	}
+
+	switch {
+	case staker.StakeAmount != nil:
+		position.StakeAmount = strconv.FormatUint(*staker.StakeAmount, 10)
+	case staker.Weight != nil:
+		position.StakeAmount = strconv.FormatUint(*staker.Weight, 10)
+	}
+
+	var err error
+	if position.RewardOwner, err = formatRewardOwner(rewardOwner, hrp); err != nil {
+		return nil, err
+	}
+	if position.DelegationRewardOwner, err = formatRewardOwner(delegationRewardOwner, hrp); err != nil {
+		return nil, err
+	}
+	return position, nil
+}
+
+// ownsRewards returns true if one of [addrs] is a reward owner of [owner]
+func ownsRewards(addrs ids.ShortSet, owner *platformvm.ClientOwner) bool {
+	if owner == nil {
+		return false
+	}
+	for _, addr := range owner.Addresses {
+		if addrs.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatRewardOwner(owner *platformvm.ClientOwner, hrp string) (*pmapper.RewardOwner, error) {
+	if owner == nil {
+		return nil, nil
+	}
+
+	rewardOwner := &pmapper.RewardOwner{
+		Addresses: make([]string, 0, len(owner.Addresses)),
+		Threshold: owner.Threshold,
+		Locktime:  owner.Locktime,
+	}
+	for _, addr := range owner.Addresses {
+		addrString, err := address.Format(mapper.PChainNetworkIdentifier, hrp, addr[:])
+		if err != nil {
+			return nil, err
+		}
+		rewardOwner.Addresses = append(rewardOwner.Addresses, addrString)
+	}
+	return rewardOwner, nil
+}
+
+func toClientOwner(owner fx.Owner) (*platformvm.ClientOwner, error) {
+	outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, errUnknownRewardOwner
+	}
+	return &platformvm.ClientOwner{
+		Locktime:  outputOwners.Locktime,
+		Threshold: outputOwners.Threshold,
+		Addresses: outputOwners.Addrs,
+	}, nil
+}
+
+func toClientStaker(staker platformapi.Staker) platformvm.ClientStaker {
+	return platformvm.ClientStaker{
+		TxID:        staker.TxID,
+		StartTime:   uint64(staker.StartTime),
+		EndTime:     uint64(staker.EndTime),
+		Weight:      (*uint64)(staker.Weight),
+		StakeAmount: (*uint64)(staker.StakeAmount),
+		NodeID:      staker.NodeID,
+	}
+}
+
+// decodeStaker decodes a staker returned by platform.getPendingValidators, which
+// the client leaves as a generic JSON value
+func decodeStaker(staker interface{}, dst interface{}) error {
+	stakerBytes, err := json.Marshal(staker)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(stakerBytes, dst)
+}
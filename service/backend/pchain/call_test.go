@@ -0,0 +1,211 @@
+package pchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+	idxmocks "github.com/ava-labs/avalanche-rosetta/mocks/service/backend/pchain/indexer"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+func TestCall(t *testing.T) {
+	ctx := context.Background()
+	pChainMock := &mocks.PChainClient{}
+	parserMock := &idxmocks.Parser{}
+	backend := NewBackend(pChainMock, parserMock, avaxAssetID, nil)
+
+	networkIdentifier := &types.NetworkIdentifier{
+		Network: mapper.FujiNetwork,
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{
+			Network: mapper.PChainNetworkIdentifier,
+		},
+	}
+
+	addr := ids.ShortID{1}
+	otherAddr := ids.ShortID{2}
+	fujiAddr, _ := address.Format(mapper.PChainNetworkIdentifier, constants.FujiHRP, addr[:])
+	fujiOtherAddr, _ := address.Format(mapper.PChainNetworkIdentifier, constants.FujiHRP, otherAddr[:])
+
+	nodeID := ids.NodeID{3}
+	validatorTxID := ids.ID{4}
+	delegatorTxID := ids.ID{5}
+	pendingValidatorTxID := ids.ID{6}
+	pendingDelegatorTxID := ids.ID{7}
+
+	t.Run("unknown method", func(t *testing.T) {
+		resp, err := backend.Call(ctx, &types.CallRequest{
+			NetworkIdentifier: networkIdentifier,
+			Method:            "platform_unknown",
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrCallInvalidMethod.Code, err.Code)
+	})
+
+	t.Run("staking positions", func(t *testing.T) {
+		stakeAmount := uint64(2_000_000_000_000)
+		potentialReward := uint64(1_000)
+		delegatorStake := uint64(25_000_000_000)
+
+		pChainMock.Mock.On("GetStake", ctx, []ids.ShortID{addr}).
+			Return(map[ids.ID]uint64{avaxAssetID: delegatorStake}, [][]byte{}, nil).Once()
+		pChainMock.Mock.On("GetCurrentValidators", ctx, constants.PrimaryNetworkID, []ids.NodeID(nil)).
+			Return([]platformvm.ClientPermissionlessValidator{
+				{
+					ClientStaker: platformvm.ClientStaker{
+						TxID:        validatorTxID,
+						StartTime:   1,
+						EndTime:     2,
+						StakeAmount: &stakeAmount,
+						NodeID:      nodeID,
+					},
+					ValidationRewardOwner: &platformvm.ClientOwner{Threshold: 1, Addresses: []ids.ShortID{otherAddr}},
+					DelegationRewardOwner: &platformvm.ClientOwner{Threshold: 1, Addresses: []ids.ShortID{otherAddr}},
+					PotentialReward:       &potentialReward,
+					DelegationFee:         2,
+					Delegators: []platformvm.ClientDelegator{
+						{
+							ClientStaker: platformvm.ClientStaker{
+								TxID:        delegatorTxID,
+								StartTime:   1,
+								EndTime:     2,
+								StakeAmount: &delegatorStake,
+								NodeID:      nodeID,
+							},
+							RewardOwner:     &platformvm.ClientOwner{Threshold: 1, Addresses: []ids.ShortID{addr}},
+							PotentialReward: &potentialReward,
+						},
+					},
+				},
+			}, nil).Once()
+		pChainMock.Mock.On("GetPendingValidators", ctx, constants.PrimaryNetworkID, []ids.NodeID(nil)).
+			Return(
+				[]interface{}{map[string]interface{}{
+					"txID":          pendingValidatorTxID.String(),
+					"startTime":     "3",
+					"endTime":       "4",
+					"stakeAmount":   "2000000000000",
+					"nodeID":        nodeID.String(),
+					"delegationFee": "2.0000",
+					"connected":     false,
+				}},
+				[]interface{}{map[string]interface{}{
+					"txID":        pendingDelegatorTxID.String(),
+					"startTime":   "3",
+					"endTime":     "4",
+					"stakeAmount": "25000000000",
+					"nodeID":      nodeID.String(),
+				}},
+				nil,
+			).Once()
+		pChainMock.Mock.On("GetTx", ctx, pendingValidatorTxID).
+			Return(makeStakingTxBytes(t, backend, &txs.AddValidatorTx{
+				RewardsOwner: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+			}), nil).Once()
+		pChainMock.Mock.On("GetTx", ctx, pendingDelegatorTxID).
+			Return(makeStakingTxBytes(t, backend, &txs.AddDelegatorTx{
+				DelegationRewardsOwner: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+			}), nil).Once()
+
+		resp, err := backend.Call(ctx, &types.CallRequest{
+			NetworkIdentifier: networkIdentifier,
+			Method:            pmapper.CallGetStakingPositions,
+			Parameters:        map[string]interface{}{"addresses": []string{fujiAddr}},
+		})
+		assert.Nil(t, err)
+
+		var output pmapper.GetStakingPositionsOutput
+		assert.Nil(t, mapper.UnmarshalJSONMap(resp.Result, &output))
+		assert.Equal(t, "25000000000", output.Staked)
+		assert.Equal(t, []*pmapper.StakingPosition{
+			{
+				TxID:            delegatorTxID.String(),
+				Type:            pmapper.StakingPositionDelegator,
+				Status:          pmapper.StakingStatusCurrent,
+				NodeID:          nodeID.String(),
+				StartTime:       1,
+				EndTime:         2,
+				StakeAmount:     "25000000000",
+				PotentialReward: "1000",
+				RewardOwner:     &pmapper.RewardOwner{Addresses: []string{fujiAddr}, Threshold: 1},
+			},
+			{
+				TxID:                  pendingValidatorTxID.String(),
+				Type:                  pmapper.StakingPositionValidator,
+				Status:                pmapper.StakingStatusPending,
+				NodeID:                nodeID.String(),
+				StartTime:             3,
+				EndTime:               4,
+				StakeAmount:           "2000000000000",
+				DelegationFee:         2,
+				RewardOwner:           &pmapper.RewardOwner{Addresses: []string{fujiAddr}, Threshold: 1},
+				DelegationRewardOwner: &pmapper.RewardOwner{Addresses: []string{fujiAddr}, Threshold: 1},
+			},
+		}, output.Positions)
+		pChainMock.AssertExpectations(t)
+	})
+
+	t.Run("staking positions with invalid address", func(t *testing.T) {
+		resp, err := backend.Call(ctx, &types.CallRequest{
+			NetworkIdentifier: networkIdentifier,
+			Method:            pmapper.CallGetStakingPositions,
+			Parameters:        map[string]interface{}{"addresses": []string{"invalid"}},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrCallInvalidParams.Code, err.Code)
+	})
+
+	t.Run("staking rewards", func(t *testing.T) {
+		rewardUTXOBytes, errm := backend.codec.Marshal(txs.Version, &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: delegatorTxID, OutputIndex: 1},
+			Asset:  avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          1_000,
+				OutputOwners: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+			},
+		})
+		assert.Nil(t, errm)
+
+		pChainMock.Mock.On("GetRewardUTXOs", ctx, &api.GetTxArgs{TxID: delegatorTxID, Encoding: formatting.Hex}).
+			Return([][]byte{rewardUTXOBytes}, nil).Once()
+
+		resp, err := backend.Call(ctx, &types.CallRequest{
+			NetworkIdentifier: networkIdentifier,
+			Method:            pmapper.CallGetStakingRewards,
+			Parameters:        map[string]interface{}{"tx_ids": []string{delegatorTxID.String()}},
+		})
+		assert.Nil(t, err)
+
+		var output pmapper.GetStakingRewardsOutput
+		assert.Nil(t, mapper.UnmarshalJSONMap(resp.Result, &output))
+		assert.Equal(t, []*pmapper.StakingReward{
+			{
+				TxID:           delegatorTxID.String(),
+				CoinIdentifier: delegatorTxID.String() + ":1",
+				Amount:         "1000",
+				Owner:          &pmapper.RewardOwner{Addresses: []string{fujiOtherAddr}, Threshold: 1},
+			},
+		}, output.Rewards)
+		pChainMock.AssertExpectations(t)
+	})
+}
+
+func makeStakingTxBytes(t *testing.T, backend *Backend, unsignedTx txs.UnsignedTx) []byte {
+	txBytes, err := backend.codec.Marshal(txs.Version, &txs.Tx{Unsigned: unsignedTx})
+	assert.Nil(t, err)
+	return txBytes
+}
@@ -6,10 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/coinbase/rosetta-sdk-go/types"
 
 	"github.com/ava-labs/avalanche-rosetta/mapper"
@@ -22,6 +27,9 @@ var (
 	errUnknownTxType = errors.New("unknown tx type")
 	errUndecodableTx = errors.New("undecodable transaction")
 	errNoTxGiven     = errors.New("no transaction was given")
+
+	errUnknownSubnet          = errors.New("unknown subnet")
+	errInsufficientSubnetAuth = errors.New("insufficient subnet auth signers")
 )
 
 func (b *Backend) ConstructionDerive(ctx context.Context, req *types.ConstructionDeriveRequest) (*types.ConstructionDeriveResponse, *types.Error) {
@@ -68,6 +76,10 @@ func (b *Backend) ConstructionMetadata(
 		metadata, suggestedFee, err = b.buildStakingMetadata(req.Options)
 		metadata.Threshold = opMetadata.Threshold
 		metadata.Locktime = opMetadata.Locktime
+	case pmapper.OpAddPermissionlessValidator, pmapper.OpAddPermissionlessDelegator:
+		metadata, suggestedFee, err = b.buildPermissionlessStakingMetadata(ctx, opMetadata, req.Options)
+	case pmapper.OpCreateSubnet, pmapper.OpCreateChain, pmapper.OpAddSubnetValidator, pmapper.OpRemoveSubnetValidator:
+		metadata, suggestedFee, err = b.buildSubnetMetadata(ctx, req.NetworkIdentifier, opMetadata, req.Options)
 
 	default:
 		return nil, service.WrapError(
@@ -165,6 +177,11 @@ func (b *Backend) buildStakingMetadata(options map[string]interface{}) (*pmapper
 		Threshold:       preprocessOptions.Threshold,
 		RewardAddresses: preprocessOptions.RewardAddresses,
 		Shares:          preprocessOptions.Shares,
+
+		SubnetID:                  preprocessOptions.SubnetID,
+		BLSPublicKey:              preprocessOptions.BLSPublicKey,
+		BLSProofOfPossession:      preprocessOptions.BLSProofOfPossession,
+		DelegationRewardAddresses: preprocessOptions.DelegationRewardAddresses,
 	}
 
 	zeroAvax := mapper.AtomicAvaxAmount(big.NewInt(0))
@@ -172,6 +189,190 @@ func (b *Backend) buildStakingMetadata(options map[string]interface{}) (*pmapper
 	return &pmapper.Metadata{StakingMetadata: stakingMetadata}, zeroAvax, nil
 }
 
+// buildPermissionlessStakingMetadata returns the staking metadata along with
+// the fee of adding a validator or delegator to the primary network or a subnet
+func (b *Backend) buildPermissionlessStakingMetadata(
+	ctx context.Context,
+	opMetadata *pmapper.OperationMetadata,
+	options map[string]interface{},
+) (*pmapper.Metadata, *types.Amount, error) {
+	metadata, _, err := b.buildStakingMetadata(options)
+	if err != nil {
+		return nil, nil, err
+	}
+	metadata.Threshold = opMetadata.Threshold
+	metadata.Locktime = opMetadata.Locktime
+
+	fees, err := b.pClient.GetTxFee(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	primaryNetwork := metadata.SubnetID == "" || metadata.SubnetID == constants.PrimaryNetworkID.String()
+	var fee uint64
+	switch {
+	case opMetadata.Type == pmapper.OpAddPermissionlessValidator && primaryNetwork:
+		fee = uint64(fees.AddPrimaryNetworkValidatorFee)
+	case opMetadata.Type == pmapper.OpAddPermissionlessValidator:
+		fee = uint64(fees.AddSubnetValidatorFee)
+	case primaryNetwork:
+		fee = uint64(fees.AddPrimaryNetworkDelegatorFee)
+	default:
+		fee = uint64(fees.AddSubnetDelegatorFee)
+	}
+
+	return metadata, mapper.AtomicAvaxAmount(new(big.Int).SetUint64(fee)), nil
+}
+
+// buildSubnetMetadata returns the metadata of subnet transactions along with
+// their fee. Transactions other than CreateSubnet are authorized by the subnet
+// owners, which are looked up from the transaction creating the subnet.
+func (b *Backend) buildSubnetMetadata(
+	ctx context.Context,
+	networkIdentifier *types.NetworkIdentifier,
+	opMetadata *pmapper.OperationMetadata,
+	options map[string]interface{},
+) (*pmapper.Metadata, *types.Amount, error) {
+	var preprocessOptions pmapper.SubnetOptions
+	if err := mapper.UnmarshalJSONMap(options, &preprocessOptions); err != nil {
+		return nil, nil, err
+	}
+
+	subnetMetadata := &pmapper.SubnetMetadata{
+		SubnetID:  preprocessOptions.SubnetID,
+		Memo:      preprocessOptions.Memo,
+		ChainName: preprocessOptions.ChainName,
+		VMID:      preprocessOptions.VMID,
+		FxIDs:     preprocessOptions.FxIDs,
+		Genesis:   preprocessOptions.Genesis,
+		NodeID:    preprocessOptions.NodeID,
+		Start:     preprocessOptions.Start,
+		End:       preprocessOptions.End,
+		Weight:    preprocessOptions.Weight,
+	}
+
+	fees, err := b.pClient.GetTxFee(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fee uint64
+	switch opMetadata.Type {
+	case pmapper.OpCreateSubnet:
+		subnetMetadata.SubnetOwners = preprocessOptions.SubnetOwners
+		subnetMetadata.Threshold = opMetadata.Threshold
+		subnetMetadata.Locktime = opMetadata.Locktime
+		fee = uint64(fees.CreateSubnetTxFee)
+	case pmapper.OpCreateChain:
+		fee = uint64(fees.CreateBlockchainTxFee)
+	case pmapper.OpAddSubnetValidator:
+		fee = uint64(fees.AddSubnetValidatorFee)
+	default:
+		fee = uint64(fees.TxFee)
+	}
+
+	if opMetadata.Type != pmapper.OpCreateSubnet {
+		hrp, err := mapper.GetHRP(networkIdentifier)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		subnetMetadata.SubnetAuthSigIndices, subnetMetadata.SubnetAuthSigners, err = b.resolveSubnetAuthSigners(
+			ctx,
+			hrp,
+			preprocessOptions.SubnetID,
+			preprocessOptions.SubnetAuthSigners,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return &pmapper.Metadata{SubnetMetadata: subnetMetadata}, mapper.AtomicAvaxAmount(new(big.Int).SetUint64(fee)), nil
+}
+
+// resolveSubnetAuthSigners returns the indices of [signers] in the owner addresses of
+// [subnetID], sorted as required by the subnet auth, along with the signers
+// in the same order. The first threshold owners sign when none are given.
+func (b *Backend) resolveSubnetAuthSigners(
+	ctx context.Context,
+	hrp string,
+	subnetID string,
+	signers []string,
+) ([]uint32, []string, error) {
+	id, err := ids.FromString(subnetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse subnet id failed: %w", err)
+	}
+
+	txBytes, err := b.pClient.GetTx(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tx txs.Tx
+	if _, err := b.codec.Unmarshal(txBytes, &tx); err != nil {
+		return nil, nil, err
+	}
+
+	createSubnetTx, ok := tx.Unsigned.(*txs.CreateSubnetTx)
+	if !ok {
+		return nil, nil, errUnknownSubnet
+	}
+	owner, ok := createSubnetTx.Owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, nil, errUnknownSubnet
+	}
+
+	if len(signers) == 0 {
+		for i := 0; i < int(owner.Threshold) && i < len(owner.Addrs); i++ {
+			addr, err := address.Format(mapper.PChainNetworkIdentifier, hrp, owner.Addrs[i][:])
+			if err != nil {
+				return nil, nil, err
+			}
+			signers = append(signers, addr)
+		}
+	}
+
+	sigIndices := make([]uint32, 0, len(signers))
+	signerIndices := map[uint32]string{}
+	for _, signer := range signers {
+		addr, err := address.ParseToID(signer)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		index := -1
+		for i, ownerAddr := range owner.Addrs {
+			if ownerAddr == addr {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, nil, fmt.Errorf("%s is not an owner of subnet %s", signer, subnetID)
+		}
+		if _, ok := signerIndices[uint32(index)]; ok {
+			continue
+		}
+
+		sigIndices = append(sigIndices, uint32(index))
+		signerIndices[uint32(index)] = signer
+	}
+
+	if len(sigIndices) < int(owner.Threshold) {
+		return nil, nil, errInsufficientSubnetAuth
+	}
+
+	sort.Slice(sigIndices, func(i, j int) bool { return sigIndices[i] < sigIndices[j] })
+	sortedSigners := make([]string, len(sigIndices))
+	for i, index := range sigIndices {
+		sortedSigners[i] = signerIndices[index]
+	}
+
+	return sigIndices, sortedSigners, nil
+}
+
 func (b *Backend) getBaseTxFee(ctx context.Context) (*types.Amount, error) {
 	fees, err := b.pClient.GetTxFee(ctx)
 	if err != nil {
@@ -236,7 +437,12 @@ func (b *Backend) CombineTx(tx common.AvaxTx, signatures []*types.Signature) (co
 		return nil, service.WrapError(service.ErrInvalidInput, err)
 	}
 
-	creds, err := common.BuildCredentialList(ins, signatures)
+	var creds []verify.Verifiable
+	if subnetAuth := getSubnetAuth(pTx.Tx.Unsigned); subnetAuth != nil {
+		creds, err = common.BuildCredentialListWithAuth(ins, subnetAuth, signatures)
+	} else {
+		creds, err = common.BuildCredentialList(ins, signatures)
+	}
 	if err != nil {
 		return nil, service.WrapError(service.ErrInvalidInput, err)
 	}
@@ -269,10 +475,16 @@ func getTxInputs(
 		return utx.Ins, nil
 	case *txs.AddDelegatorTx:
 		return utx.Ins, nil
+	case *txs.AddPermissionlessValidatorTx:
+		return utx.Ins, nil
+	case *txs.AddPermissionlessDelegatorTx:
+		return utx.Ins, nil
 	case *txs.CreateChainTx:
 		return utx.Ins, nil
 	case *txs.CreateSubnetTx:
 		return utx.Ins, nil
+	case *txs.RemoveSubnetValidatorTx:
+		return utx.Ins, nil
 	case *txs.ImportTx:
 		return utx.ImportedInputs, nil
 	case *txs.ExportTx:
@@ -282,6 +494,22 @@ func getTxInputs(
 	}
 }
 
+// getSubnetAuth returns the subnet auth of txs authorized by subnet owners
+func getSubnetAuth(unsignedTx txs.UnsignedTx) *secp256k1fx.Input {
+	var subnetAuth verify.Verifiable
+	switch utx := unsignedTx.(type) {
+	case *txs.AddSubnetValidatorTx:
+		subnetAuth = utx.SubnetAuth
+	case *txs.RemoveSubnetValidatorTx:
+		subnetAuth = utx.SubnetAuth
+	case *txs.CreateChainTx:
+		subnetAuth = utx.SubnetAuth
+	}
+
+	input, _ := subnetAuth.(*secp256k1fx.Input)
+	return input
+}
+
 func (b *Backend) ConstructionHash(
 	ctx context.Context,
 	req *types.ConstructionHashRequest,
@@ -303,7 +531,16 @@ func (b *Backend) ConstructionSubmit(
 		return nil, service.WrapError(service.ErrInvalidInput, err)
 	}
 
-	return common.SubmitTx(ctx, b, rosettaTx)
+	resp, terr := common.SubmitTx(ctx, b, rosettaTx)
+	if terr != nil {
+		return nil, terr
+	}
+
+	// The response hash is the ID returned by the node, so it always parses
+	txID, _ := ids.FromString(resp.TransactionIdentifier.Hash)
+	b.trackIssuedTx(txID, rosettaTx.Tx.(*pTx).Tx)
+
+	return resp, nil
 }
 
 // Defining IssueTx here without rpc.Options... to be able to use it with common.SubmitTx
@@ -10,8 +10,15 @@ import (
 
 	"github.com/ava-labs/avalanchego/api/info"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	ajson "github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/stretchr/testify/assert"
 
@@ -980,11 +987,1200 @@ func TestAddDelegatorTxConstruction(t *testing.T) {
 	})
 }
 
+func TestAddPermissionlessValidatorTxConstruction(t *testing.T) {
+	opAddPermissionlessValidator := "ADD_PERMISSIONLESS_VALIDATOR"
+	startTime := uint64(1659592163)
+	endTime := startTime + 14*86400
+	shares := uint32(200000)
+	blsPublicKey := "0x97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb"
+	blsProofOfPossession := "0xabd367bf7fe788f30632c5d7e92a9958da6164eea2f0cc2d4678a1bcc281f1bede7fc92f5624c84718da7c203f8f69cc016b555c691666c80d48dbebdbb5985eff6618683e563660d926ab2e336376e011717f4d35754ba8cac2b33e0ab21f9a"
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			RelatedOperations:   nil,
+			Type:                opAddPermissionlessValidator,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(-2_000_000_000_000)),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: coinID1},
+				CoinAction:     "coin_spent",
+			},
+			Metadata: map[string]interface{}{
+				"type":        opTypeInput,
+				"sig_indices": []interface{}{0.0},
+				"locktime":    0.0,
+			},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                opAddPermissionlessValidator,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(2_000_000_000_000)),
+			Metadata: map[string]interface{}{
+				"type":      opTypeStake,
+				"locktime":  0.0,
+				"threshold": 1.0,
+				// the following are ignored by payloads endpoint but generated by parse
+				// added here so that we can simply compare with parse outputs
+				"staking_start_time": startTime,
+				"staking_end_time":   endTime,
+				"validator_node_id":  nodeID,
+			},
+		},
+	}
+
+	preprocessMetadata := map[string]interface{}{
+		"node_id":                     nodeID,
+		"start":                       startTime,
+		"end":                         endTime,
+		"shares":                      shares,
+		"reward_addresses":            []string{stakeRewardAccount.Address},
+		"delegation_reward_addresses": []string{pAccountIdentifier.Address},
+		"bls_public_key":              blsPublicKey,
+		"bls_proof_of_possession":     blsProofOfPossession,
+	}
+
+	metadataOptions := map[string]interface{}{
+		"type":                        opAddPermissionlessValidator,
+		"node_id":                     nodeID,
+		"start":                       startTime,
+		"end":                         endTime,
+		"shares":                      shares,
+		"reward_addresses":            []string{stakeRewardAccount.Address},
+		"delegation_reward_addresses": []string{pAccountIdentifier.Address},
+		"bls_public_key":              blsPublicKey,
+		"bls_proof_of_possession":     blsProofOfPossession,
+	}
+
+	payloadsMetadata := map[string]interface{}{
+		"network_id":                  float64(networkID),
+		"blockchain_id":               pChainID.String(),
+		"node_id":                     nodeID,
+		"start":                       float64(startTime),
+		"end":                         float64(endTime),
+		"shares":                      float64(shares),
+		"locktime":                    0.0,
+		"threshold":                   1.0,
+		"memo":                        "",
+		"reward_addresses":            []interface{}{stakeRewardAccount.Address},
+		"delegation_reward_addresses": []interface{}{pAccountIdentifier.Address},
+		"bls_public_key":              blsPublicKey,
+		"bls_proof_of_possession":     blsProofOfPossession,
+	}
+
+	signers := []*types.AccountIdentifier{pAccountIdentifier}
+	stakeSigners := buildRosettaSignerJSON([]string{coinID1}, signers)
+
+	unsignedTx := "0x0000000000190000000500000000000000000000000000000000000000000000000000000000000000000000000000000001f52a5a6dd8f1b3fe05204bdab4f6bcb5a7059f88d0443c636f6c158f838dd1a8000000003d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa00000005000001d1a94a200000000001000000000000000077e1d5c6c289c49976f744749d54369d2129d7500000000062eb5de30000000062fdd2e3000001d1a94a200000000000000000000000000000000000000000000000000000000000000000000000001c97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bbabd367bf7fe788f30632c5d7e92a9958da6164eea2f0cc2d4678a1bcc281f1bede7fc92f5624c84718da7c203f8f69cc016b555c691666c80d48dbebdbb5985eff6618683e563660d926ab2e336376e011717f4d35754ba8cac2b33e0ab21f9a000000013d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa00000007000001d1a94a2000000000000000000000000001000000015445cd01d75b4a06b6b41939193c0b1c5544490d0000000b00000000000000000000000100000001cf7cd358e2e882449d68c1c8889889eaf247b7200000000b000000000000000000000001000000015445cd01d75b4a06b6b41939193c0b1c5544490d00030d400000000047a1645b"
+	unsignedTxHash, _ := hex.DecodeString("0c5922eb77ed2844085b980d8480cba7870ac6c1957517c5c1c19aee7521cd15")
+	wrappedUnsignedTx := `{"tx":"` + unsignedTx + `","signers":` + stakeSigners + `}`
+
+	signingPayloads := []*types.SigningPayload{
+		{
+			AccountIdentifier: pAccountIdentifier,
+			Bytes:             unsignedTxHash,
+			SignatureType:     types.EcdsaRecovery,
+		},
+	}
+
+	signedTx := "0x0000000000190000000500000000000000000000000000000000000000000000000000000000000000000000000000000001f52a5a6dd8f1b3fe05204bdab4f6bcb5a7059f88d0443c636f6c158f838dd1a8000000003d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa00000005000001d1a94a200000000001000000000000000077e1d5c6c289c49976f744749d54369d2129d7500000000062eb5de30000000062fdd2e3000001d1a94a200000000000000000000000000000000000000000000000000000000000000000000000001c97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bbabd367bf7fe788f30632c5d7e92a9958da6164eea2f0cc2d4678a1bcc281f1bede7fc92f5624c84718da7c203f8f69cc016b555c691666c80d48dbebdbb5985eff6618683e563660d926ab2e336376e011717f4d35754ba8cac2b33e0ab21f9a000000013d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa00000007000001d1a94a2000000000000000000000000001000000015445cd01d75b4a06b6b41939193c0b1c5544490d0000000b00000000000000000000000100000001cf7cd358e2e882449d68c1c8889889eaf247b7200000000b000000000000000000000001000000015445cd01d75b4a06b6b41939193c0b1c5544490d00030d400000000100000009000000017403e32bb967e71902a988b7da635b4bca2475eedbfd23176610a88162f3a92f20b61f2185825b04b7f8ee8c76427c8dc80eb6091f9e594ef259a59856e5401b01e37cbc03"
+	signedTxSignature, _ := hex.DecodeString("7403e32bb967e71902a988b7da635b4bca2475eedbfd23176610a88162f3a92f20b61f2185825b04b7f8ee8c76427c8dc80eb6091f9e594ef259a59856e5401b01")
+	signedTxHash := "6wg2sDoTyjNmE7DqZ4zAhwug2MyDiKouieNwkBaMG7j2miK9B"
+
+	wrappedSignedTx := `{"tx":"` + signedTx + `","signers":` + stakeSigners + `}`
+
+	signatures := []*types.Signature{{
+		SigningPayload: &types.SigningPayload{
+			AccountIdentifier: cAccountIdentifier,
+			Bytes:             unsignedTxHash,
+			SignatureType:     types.EcdsaRecovery,
+		},
+		SignatureType: types.EcdsaRecovery,
+		Bytes:         signedTxSignature,
+	}}
+
+	ctx := context.Background()
+	clientMock := &mocks.PChainClient{}
+	backend := NewBackend(clientMock, nil, avaxAssetID, pChainNetworkIdentifier)
+
+	t.Run("preprocess endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionPreprocess(
+			ctx,
+			&types.ConstructionPreprocessRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Operations:        operations,
+				Metadata:          preprocessMetadata,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, metadataOptions, resp.Options)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("metadata endpoint", func(t *testing.T) {
+		clientMock.On("GetNetworkID", ctx).Return(uint32(networkID), nil)
+		clientMock.On("GetBlockchainID", ctx, mapper.PChainNetworkIdentifier).Return(pChainID, nil)
+		clientMock.On("GetTxFee", ctx).Return(&info.GetTxFeeResponse{
+			AddPrimaryNetworkValidatorFee: 0,
+			AddSubnetValidatorFee:         ajson.Uint64(txFee),
+		}, nil)
+
+		resp, err := backend.ConstructionMetadata(
+			ctx,
+			&types.ConstructionMetadataRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Options:           metadataOptions,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, payloadsMetadata, resp.Metadata)
+		assert.Equal(t, []*types.Amount{mapper.AtomicAvaxAmount(big.NewInt(0))}, resp.SuggestedFee)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("payloads endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionPayloads(
+			ctx,
+			&types.ConstructionPayloadsRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Operations:        operations,
+				Metadata:          payloadsMetadata,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, wrappedUnsignedTx, resp.UnsignedTransaction)
+		assert.Equal(t, signingPayloads, resp.Payloads,
+			"signing payloads mismatch: %s %s",
+			marshalSigningPayloads(signingPayloads),
+			marshalSigningPayloads(resp.Payloads))
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("payloads endpoint requires a proof of possession", func(t *testing.T) {
+		metadata := map[string]interface{}{}
+		for k, v := range payloadsMetadata {
+			metadata[k] = v
+		}
+		delete(metadata, "bls_proof_of_possession")
+
+		_, err := backend.ConstructionPayloads(
+			ctx,
+			&types.ConstructionPayloadsRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Operations:        operations,
+				Metadata:          metadata,
+			},
+		)
+		assert.Equal(t, service.ErrInvalidInput.Code, err.Code)
+	})
+
+	t.Run("parse endpoint (unsigned)", func(t *testing.T) {
+		resp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       wrappedUnsignedTx,
+				Signed:            false,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Nil(t, resp.AccountIdentifierSigners)
+		assert.Equal(t, operations, resp.Operations)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("combine endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionCombine(
+			ctx,
+			&types.ConstructionCombineRequest{
+				NetworkIdentifier:   pChainNetworkIdentifier,
+				UnsignedTransaction: wrappedUnsignedTx,
+				Signatures:          signatures,
+			},
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, wrappedSignedTx, resp.SignedTransaction)
+	})
+
+	t.Run("parse endpoint (signed)", func(t *testing.T) {
+		resp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       wrappedSignedTx,
+				Signed:            true,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, signers, resp.AccountIdentifierSigners)
+		assert.Equal(t, operations, resp.Operations)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("hash endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionHash(ctx, &types.ConstructionHashRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			SignedTransaction: wrappedSignedTx,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, signedTxHash, resp.TransactionIdentifier.Hash)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("submit endpoint", func(t *testing.T) {
+		signedTxBytes, _ := formatting.Decode(formatting.Hex, signedTx)
+		txID, _ := ids.FromString(signedTxHash)
+
+		clientMock.On("IssueTx", ctx, signedTxBytes).Return(txID, nil)
+
+		resp, apiErr := backend.ConstructionSubmit(ctx, &types.ConstructionSubmitRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			SignedTransaction: wrappedSignedTx,
+		})
+
+		assert.Nil(t, apiErr)
+		assert.Equal(t, signedTxHash, resp.TransactionIdentifier.Hash)
+
+		clientMock.AssertExpectations(t)
+	})
+}
+
+func TestAddPermissionlessDelegatorTxConstruction(t *testing.T) {
+	opAddPermissionlessDelegator := "ADD_PERMISSIONLESS_DELEGATOR"
+	startTime := uint64(1659592163)
+	endTime := startTime + 14*86400
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			RelatedOperations:   nil,
+			Type:                opAddPermissionlessDelegator,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(-25_000_000_000)),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: coinID1},
+				CoinAction:     "coin_spent",
+			},
+			Metadata: map[string]interface{}{
+				"type":        opTypeInput,
+				"sig_indices": []interface{}{0.0},
+				"locktime":    0.0,
+			},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                opAddPermissionlessDelegator,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(25_000_000_000)),
+			Metadata: map[string]interface{}{
+				"type":      opTypeStake,
+				"locktime":  0.0,
+				"threshold": 1.0,
+				// the following are ignored by payloads endpoint but generated by parse
+				// added here so that we can simply compare with parse outputs
+				"staking_start_time": startTime,
+				"staking_end_time":   endTime,
+				"validator_node_id":  nodeID,
+			},
+		},
+	}
+
+	preprocessMetadata := map[string]interface{}{
+		"node_id":          nodeID,
+		"start":            startTime,
+		"end":              endTime,
+		"reward_addresses": []string{stakeRewardAccount.Address},
+	}
+
+	metadataOptions := map[string]interface{}{
+		"type":             opAddPermissionlessDelegator,
+		"node_id":          nodeID,
+		"start":            startTime,
+		"end":              endTime,
+		"reward_addresses": []string{stakeRewardAccount.Address},
+	}
+
+	payloadsMetadata := map[string]interface{}{
+		"network_id":       float64(networkID),
+		"blockchain_id":    pChainID.String(),
+		"node_id":          nodeID,
+		"start":            float64(startTime),
+		"end":              float64(endTime),
+		"shares":           0.0,
+		"locktime":         0.0,
+		"threshold":        1.0,
+		"memo":             "",
+		"reward_addresses": []interface{}{stakeRewardAccount.Address},
+	}
+
+	signers := []*types.AccountIdentifier{pAccountIdentifier}
+	stakeSigners := buildRosettaSignerJSON([]string{coinID1}, signers)
+
+	unsignedTx := "0x00000000001a0000000500000000000000000000000000000000000000000000000000000000000000000000000000000001f52a5a6dd8f1b3fe05204bdab4f6bcb5a7059f88d0443c636f6c158f838dd1a8000000003d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa0000000500000005d21dba0000000001000000000000000077e1d5c6c289c49976f744749d54369d2129d7500000000062eb5de30000000062fdd2e300000005d21dba000000000000000000000000000000000000000000000000000000000000000000000000013d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa0000000700000005d21dba00000000000000000000000001000000015445cd01d75b4a06b6b41939193c0b1c5544490d0000000b00000000000000000000000100000001cf7cd358e2e882449d68c1c8889889eaf247b72000000000a2b13d65"
+	unsignedTxHash, _ := hex.DecodeString("7dfc1c83e13200026f7d5f3e4f8b3de91d46bd1ca29f0fdc03130358a4605eff")
+	wrappedUnsignedTx := `{"tx":"` + unsignedTx + `","signers":` + stakeSigners + `}`
+
+	signingPayloads := []*types.SigningPayload{
+		{
+			AccountIdentifier: pAccountIdentifier,
+			Bytes:             unsignedTxHash,
+			SignatureType:     types.EcdsaRecovery,
+		},
+	}
+
+	signedTx := "0x00000000001a0000000500000000000000000000000000000000000000000000000000000000000000000000000000000001f52a5a6dd8f1b3fe05204bdab4f6bcb5a7059f88d0443c636f6c158f838dd1a8000000003d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa0000000500000005d21dba0000000001000000000000000077e1d5c6c289c49976f744749d54369d2129d7500000000062eb5de30000000062fdd2e300000005d21dba000000000000000000000000000000000000000000000000000000000000000000000000013d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa0000000700000005d21dba00000000000000000000000001000000015445cd01d75b4a06b6b41939193c0b1c5544490d0000000b00000000000000000000000100000001cf7cd358e2e882449d68c1c8889889eaf247b7200000000100000009000000017403e32bb967e71902a988b7da635b4bca2475eedbfd23176610a88162f3a92f20b61f2185825b04b7f8ee8c76427c8dc80eb6091f9e594ef259a59856e5401b017eca6504"
+	signedTxSignature, _ := hex.DecodeString("7403e32bb967e71902a988b7da635b4bca2475eedbfd23176610a88162f3a92f20b61f2185825b04b7f8ee8c76427c8dc80eb6091f9e594ef259a59856e5401b01")
+	signedTxHash := "Yc1doxoWw8mgfWyyVkppVxWcfW9jdG29FDM9rA1SbHXCez8Vw"
+
+	wrappedSignedTx := `{"tx":"` + signedTx + `","signers":` + stakeSigners + `}`
+
+	signatures := []*types.Signature{{
+		SigningPayload: &types.SigningPayload{
+			AccountIdentifier: cAccountIdentifier,
+			Bytes:             unsignedTxHash,
+			SignatureType:     types.EcdsaRecovery,
+		},
+		SignatureType: types.EcdsaRecovery,
+		Bytes:         signedTxSignature,
+	}}
+
+	ctx := context.Background()
+	clientMock := &mocks.PChainClient{}
+	backend := NewBackend(clientMock, nil, avaxAssetID, pChainNetworkIdentifier)
+
+	t.Run("preprocess endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionPreprocess(
+			ctx,
+			&types.ConstructionPreprocessRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Operations:        operations,
+				Metadata:          preprocessMetadata,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, metadataOptions, resp.Options)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("metadata endpoint", func(t *testing.T) {
+		clientMock.On("GetNetworkID", ctx).Return(uint32(networkID), nil)
+		clientMock.On("GetBlockchainID", ctx, mapper.PChainNetworkIdentifier).Return(pChainID, nil)
+		clientMock.On("GetTxFee", ctx).Return(&info.GetTxFeeResponse{
+			AddPrimaryNetworkDelegatorFee: 0,
+			AddSubnetDelegatorFee:         ajson.Uint64(txFee),
+		}, nil)
+
+		resp, err := backend.ConstructionMetadata(
+			ctx,
+			&types.ConstructionMetadataRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Options:           metadataOptions,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, payloadsMetadata, resp.Metadata)
+		assert.Equal(t, []*types.Amount{mapper.AtomicAvaxAmount(big.NewInt(0))}, resp.SuggestedFee)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("payloads endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionPayloads(
+			ctx,
+			&types.ConstructionPayloadsRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Operations:        operations,
+				Metadata:          payloadsMetadata,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, wrappedUnsignedTx, resp.UnsignedTransaction)
+		assert.Equal(t, signingPayloads, resp.Payloads,
+			"signing payloads mismatch: %s %s",
+			marshalSigningPayloads(signingPayloads),
+			marshalSigningPayloads(resp.Payloads))
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("parse endpoint (unsigned)", func(t *testing.T) {
+		resp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       wrappedUnsignedTx,
+				Signed:            false,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Nil(t, resp.AccountIdentifierSigners)
+		assert.Equal(t, operations, resp.Operations)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("combine endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionCombine(
+			ctx,
+			&types.ConstructionCombineRequest{
+				NetworkIdentifier:   pChainNetworkIdentifier,
+				UnsignedTransaction: wrappedUnsignedTx,
+				Signatures:          signatures,
+			},
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, wrappedSignedTx, resp.SignedTransaction)
+	})
+
+	t.Run("parse endpoint (signed)", func(t *testing.T) {
+		resp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       wrappedSignedTx,
+				Signed:            true,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, signers, resp.AccountIdentifierSigners)
+		assert.Equal(t, operations, resp.Operations)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("hash endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionHash(ctx, &types.ConstructionHashRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			SignedTransaction: wrappedSignedTx,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, signedTxHash, resp.TransactionIdentifier.Hash)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("submit endpoint", func(t *testing.T) {
+		signedTxBytes, _ := formatting.Decode(formatting.Hex, signedTx)
+		txID, _ := ids.FromString(signedTxHash)
+
+		clientMock.On("IssueTx", ctx, signedTxBytes).Return(txID, nil)
+
+		resp, apiErr := backend.ConstructionSubmit(ctx, &types.ConstructionSubmitRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			SignedTransaction: wrappedSignedTx,
+		})
+
+		assert.Nil(t, apiErr)
+		assert.Equal(t, signedTxHash, resp.TransactionIdentifier.Hash)
+
+		clientMock.AssertExpectations(t)
+	})
+}
+
 func marshalSigningPayloads(payloads []*types.SigningPayload) string {
 	bytes, err := json.Marshal(payloads)
 	if err != nil {
 		return "FAILED_TO_MARSHAL"
 	}
 
-	return string(bytes)
+	return string(bytes)
+}
+
+func TestAddSubnetValidatorTxConstruction(t *testing.T) {
+	opAddSubnetValidator := "ADD_SUBNET_VALIDATOR"
+	startTime := uint64(1659592163)
+	endTime := startTime + 14*86400
+	weight := uint64(20)
+	subnetID := ids.ID{'s', 'u', 'b', 'n', 'e', 't'}
+
+	pAddr, _ := address.ParseToID(pAccountIdentifier.Address)
+	stakeRewardAddr, _ := address.ParseToID(stakeRewardAccount.Address)
+	subnetOwners := []ids.ShortID{pAddr, stakeRewardAddr}
+	ids.SortShortIDs(subnetOwners)
+
+	createSubnetTxBytes, _ := blocks.Codec.Marshal(txs.Version, &txs.Tx{Unsigned: &txs.CreateSubnetTx{
+		Owner: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: subnetOwners},
+	}})
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			RelatedOperations:   nil,
+			Type:                opAddSubnetValidator,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(-2_000_000)),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: coinID1},
+				CoinAction:     "coin_spent",
+			},
+			Metadata: map[string]interface{}{
+				"type":        opTypeInput,
+				"sig_indices": []interface{}{0.0},
+				"locktime":    0.0,
+				// the following are ignored by payloads endpoint but generated by parse
+				// added here so that we can simply compare with parse outputs
+				"subnet_id":          subnetID.String(),
+				"validator_node_id":  nodeID,
+				"validator_weight":   weight,
+				"staking_start_time": startTime,
+				"staking_end_time":   endTime,
+			},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                opAddSubnetValidator,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(1_000_000)),
+			Metadata: map[string]interface{}{
+				"type":      opTypeOutput,
+				"locktime":  0.0,
+				"threshold": 1.0,
+			},
+		},
+	}
+
+	preprocessMetadata := map[string]interface{}{
+		"subnet_id":           subnetID.String(),
+		"node_id":             nodeID,
+		"start":               startTime,
+		"end":                 endTime,
+		"weight":              weight,
+		"subnet_auth_signers": []string{stakeRewardAccount.Address},
+	}
+
+	metadataOptions := map[string]interface{}{
+		"type":                opAddSubnetValidator,
+		"subnet_id":           subnetID.String(),
+		"node_id":             nodeID,
+		"start":               startTime,
+		"end":                 endTime,
+		"weight":              weight,
+		"subnet_auth_signers": []string{stakeRewardAccount.Address},
+	}
+
+	payloadsMetadata := map[string]interface{}{
+		"network_id":    float64(networkID),
+		"blockchain_id": pChainID.String(),
+		"subnet": map[string]interface{}{
+			"subnet_id":               subnetID.String(),
+			"node_id":                 nodeID,
+			"start":                   float64(startTime),
+			"end":                     float64(endTime),
+			"weight":                  float64(weight),
+			"subnet_auth_sig_indices": []interface{}{1.0},
+			"subnet_auth_signers":     []interface{}{stakeRewardAccount.Address},
+		},
+	}
+
+	signers := []*types.AccountIdentifier{pAccountIdentifier, stakeRewardAccount}
+	inputSigners := buildRosettaSignerJSON([]string{coinID1}, signers[:1])
+	subnetAuthSigners := `[{"address":"` + stakeRewardAccount.Address + `"}]`
+
+	unsignedTx := "0x00000000000d000000050000000000000000000000000000000000000000000000000000000000000000000000013d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa0000000700000000000f4240000000000000000000000001000000015445cd01d75b4a06b6b41939193c0b1c5544490d00000001f52a5a6dd8f1b3fe05204bdab4f6bcb5a7059f88d0443c636f6c158f838dd1a8000000003d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa0000000500000000001e848000000001000000000000000077e1d5c6c289c49976f744749d54369d2129d7500000000062eb5de30000000062fdd2e300000000000000147375626e657400000000000000000000000000000000000000000000000000000000000a0000000100000001000000008b71d42c"
+	unsignedTxHash, _ := hex.DecodeString("b8b604b4e75490d5c5632acdb4ee1655d93fe4ab2f192ba6e37337d59a9a2b36")
+	wrappedUnsignedTx := `{"tx":"` + unsignedTx + `","signers":` + inputSigners + `,"subnet_auth_signers":` + subnetAuthSigners + `}`
+
+	signingPayloads := []*types.SigningPayload{
+		{
+			AccountIdentifier: pAccountIdentifier,
+			Bytes:             unsignedTxHash,
+			SignatureType:     types.EcdsaRecovery,
+		},
+		{
+			AccountIdentifier: stakeRewardAccount,
+			Bytes:             unsignedTxHash,
+			SignatureType:     types.EcdsaRecovery,
+		},
+	}
+
+	signedTx := "0x00000000000d000000050000000000000000000000000000000000000000000000000000000000000000000000013d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa0000000700000000000f4240000000000000000000000001000000015445cd01d75b4a06b6b41939193c0b1c5544490d00000001f52a5a6dd8f1b3fe05204bdab4f6bcb5a7059f88d0443c636f6c158f838dd1a8000000003d9bdac0ed1d761330cf680efdeb1a42159eb387d6d2950c96f7d28f61bbe2aa0000000500000000001e848000000001000000000000000077e1d5c6c289c49976f744749d54369d2129d7500000000062eb5de30000000062fdd2e300000000000000147375626e657400000000000000000000000000000000000000000000000000000000000a00000001000000010000000200000009000000017403e32bb967e71902a988b7da635b4bca2475eedbfd23176610a88162f3a92f20b61f2185825b04b7f8ee8c76427c8dc80eb6091f9e594ef259a59856e5401b0100000009000000017403e32bb967e71902a988b7da635b4bca2475eedbfd23176610a88162f3a92f20b61f2185825b04b7f8ee8c76427c8dc80eb6091f9e594ef259a59856e5401b01a4a05850"
+	signedTxSignature, _ := hex.DecodeString("7403e32bb967e71902a988b7da635b4bca2475eedbfd23176610a88162f3a92f20b61f2185825b04b7f8ee8c76427c8dc80eb6091f9e594ef259a59856e5401b01")
+	signedTxHash := "DnHbGXPHcuHJ6YxM2nCJm9fWm4mHBMNvjUuAc4v6wF2Qzs2ne"
+
+	wrappedSignedTx := `{"tx":"` + signedTx + `","signers":` + inputSigners + `,"subnet_auth_signers":` + subnetAuthSigners + `}`
+
+	signatures := []*types.Signature{}
+	for _, payload := range signingPayloads {
+		signatures = append(signatures, &types.Signature{
+			SigningPayload: payload,
+			SignatureType:  types.EcdsaRecovery,
+			Bytes:          signedTxSignature,
+		})
+	}
+
+	ctx := context.Background()
+	clientMock := &mocks.PChainClient{}
+	backend := NewBackend(clientMock, nil, avaxAssetID, pChainNetworkIdentifier)
+
+	t.Run("preprocess endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionPreprocess(
+			ctx,
+			&types.ConstructionPreprocessRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Operations:        operations,
+				Metadata:          preprocessMetadata,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, metadataOptions, resp.Options)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("metadata endpoint", func(t *testing.T) {
+		clientMock.On("GetNetworkID", ctx).Return(uint32(networkID), nil)
+		clientMock.On("GetBlockchainID", ctx, mapper.PChainNetworkIdentifier).Return(pChainID, nil)
+		clientMock.On("GetTxFee", ctx).Return(&info.GetTxFeeResponse{
+			AddSubnetValidatorFee: ajson.Uint64(txFee),
+		}, nil)
+		clientMock.On("GetTx", ctx, subnetID).Return(createSubnetTxBytes, nil)
+
+		resp, err := backend.ConstructionMetadata(
+			ctx,
+			&types.ConstructionMetadataRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Options:           metadataOptions,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, payloadsMetadata, resp.Metadata)
+		assert.Equal(t, []*types.Amount{mapper.AtomicAvaxAmount(big.NewInt(int64(txFee)))}, resp.SuggestedFee)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("metadata endpoint rejects signers not owning the subnet", func(t *testing.T) {
+		options := map[string]interface{}{}
+		for k, v := range metadataOptions {
+			options[k] = v
+		}
+		options["subnet_auth_signers"] = []string{"P-fuji10qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqtu3l4m"}
+
+		_, err := backend.ConstructionMetadata(
+			ctx,
+			&types.ConstructionMetadataRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Options:           options,
+			},
+		)
+		assert.Equal(t, service.ErrInternalError.Code, err.Code)
+	})
+
+	t.Run("payloads endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionPayloads(
+			ctx,
+			&types.ConstructionPayloadsRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Operations:        operations,
+				Metadata:          payloadsMetadata,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, wrappedUnsignedTx, resp.UnsignedTransaction)
+		assert.Equal(t, signingPayloads, resp.Payloads,
+			"signing payloads mismatch: %s %s",
+			marshalSigningPayloads(signingPayloads),
+			marshalSigningPayloads(resp.Payloads))
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("parse endpoint (unsigned)", func(t *testing.T) {
+		resp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       wrappedUnsignedTx,
+				Signed:            false,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Nil(t, resp.AccountIdentifierSigners)
+		assert.Equal(t, operations, resp.Operations)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("combine endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionCombine(
+			ctx,
+			&types.ConstructionCombineRequest{
+				NetworkIdentifier:   pChainNetworkIdentifier,
+				UnsignedTransaction: wrappedUnsignedTx,
+				Signatures:          signatures,
+			},
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, wrappedSignedTx, resp.SignedTransaction)
+	})
+
+	t.Run("combine endpoint requires subnet auth signatures", func(t *testing.T) {
+		_, err := backend.ConstructionCombine(
+			ctx,
+			&types.ConstructionCombineRequest{
+				NetworkIdentifier:   pChainNetworkIdentifier,
+				UnsignedTransaction: wrappedUnsignedTx,
+				Signatures:          signatures[:1],
+			},
+		)
+
+		assert.Equal(t, service.ErrInvalidInput.Code, err.Code)
+	})
+
+	t.Run("parse endpoint (signed)", func(t *testing.T) {
+		resp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       wrappedSignedTx,
+				Signed:            true,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, signers, resp.AccountIdentifierSigners)
+		assert.Equal(t, operations, resp.Operations)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("hash endpoint", func(t *testing.T) {
+		resp, err := backend.ConstructionHash(ctx, &types.ConstructionHashRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			SignedTransaction: wrappedSignedTx,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, signedTxHash, resp.TransactionIdentifier.Hash)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("submit endpoint", func(t *testing.T) {
+		signedTxBytes, _ := formatting.Decode(formatting.Hex, signedTx)
+		txID, _ := ids.FromString(signedTxHash)
+
+		clientMock.On("IssueTx", ctx, signedTxBytes).Return(txID, nil)
+
+		resp, apiErr := backend.ConstructionSubmit(ctx, &types.ConstructionSubmitRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			SignedTransaction: wrappedSignedTx,
+		})
+
+		assert.Nil(t, apiErr)
+		assert.Equal(t, signedTxHash, resp.TransactionIdentifier.Hash)
+
+		clientMock.AssertExpectations(t)
+	})
+}
+
+func TestCreateChainTxConstruction(t *testing.T) {
+	opCreateChain := "CREATE_CHAIN"
+	subnetID := ids.ID{'s', 'u', 'b', 'n', 'e', 't'}
+	vmID := ids.ID{'v', 'm'}
+	genesis, _ := mapper.EncodeBytes([]byte("{}"))
+
+	pAddr, _ := address.ParseToID(pAccountIdentifier.Address)
+	stakeRewardAddr, _ := address.ParseToID(stakeRewardAccount.Address)
+	subnetOwners := []ids.ShortID{pAddr, stakeRewardAddr}
+	ids.SortShortIDs(subnetOwners)
+
+	createSubnetTxBytes, _ := blocks.Codec.Marshal(txs.Version, &txs.Tx{Unsigned: &txs.CreateSubnetTx{
+		Owner: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: subnetOwners},
+	}})
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			RelatedOperations:   nil,
+			Type:                opCreateChain,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(-2_000_000)),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: coinID1},
+				CoinAction:     "coin_spent",
+			},
+			Metadata: map[string]interface{}{
+				"type":        opTypeInput,
+				"sig_indices": []interface{}{0.0},
+				"locktime":    0.0,
+				"subnet_id":   subnetID.String(),
+				"chain_name":  "rosetta",
+				"vm_id":       vmID.String(),
+				"fx_ids":      []string{},
+			},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                opCreateChain,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(1_000_000)),
+			Metadata: map[string]interface{}{
+				"type":      opTypeOutput,
+				"locktime":  0.0,
+				"threshold": 1.0,
+			},
+		},
+	}
+
+	metadataOptions := map[string]interface{}{
+		"type":       opCreateChain,
+		"subnet_id":  subnetID.String(),
+		"chain_name": "rosetta",
+		"vm_id":      vmID.String(),
+		"genesis":    genesis,
+	}
+
+	// The first owner of the subnet signs when no signers are given
+	payloadsMetadata := map[string]interface{}{
+		"network_id":    float64(networkID),
+		"blockchain_id": pChainID.String(),
+		"subnet": map[string]interface{}{
+			"subnet_id":               subnetID.String(),
+			"chain_name":              "rosetta",
+			"vm_id":                   vmID.String(),
+			"genesis":                 genesis,
+			"subnet_auth_sig_indices": []interface{}{0.0},
+			"subnet_auth_signers":     []interface{}{pAccountIdentifier.Address},
+		},
+	}
+
+	ctx := context.Background()
+	clientMock := &mocks.PChainClient{}
+	backend := NewBackend(clientMock, nil, avaxAssetID, pChainNetworkIdentifier)
+
+	t.Run("metadata endpoint", func(t *testing.T) {
+		clientMock.On("GetNetworkID", ctx).Return(uint32(networkID), nil)
+		clientMock.On("GetBlockchainID", ctx, mapper.PChainNetworkIdentifier).Return(pChainID, nil)
+		clientMock.On("GetTxFee", ctx).Return(&info.GetTxFeeResponse{
+			CreateBlockchainTxFee: ajson.Uint64(txFee),
+		}, nil)
+		clientMock.On("GetTx", ctx, subnetID).Return(createSubnetTxBytes, nil)
+
+		resp, err := backend.ConstructionMetadata(
+			ctx,
+			&types.ConstructionMetadataRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Options:           metadataOptions,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, payloadsMetadata, resp.Metadata)
+		assert.Equal(t, []*types.Amount{mapper.AtomicAvaxAmount(big.NewInt(int64(txFee)))}, resp.SuggestedFee)
+
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("payloads and parse endpoints", func(t *testing.T) {
+		resp, err := backend.ConstructionPayloads(
+			ctx,
+			&types.ConstructionPayloadsRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Operations:        operations,
+				Metadata:          payloadsMetadata,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Len(t, resp.Payloads, 2)
+		assert.Equal(t, pAccountIdentifier, resp.Payloads[1].AccountIdentifier)
+
+		parseResp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       resp.UnsignedTransaction,
+				Signed:            false,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, operations, parseResp.Operations)
+	})
+}
+
+func TestMultisigTxConstruction(t *testing.T) {
+	opExportAvax := "EXPORT_AVAX"
+
+	pAddr, _ := address.ParseToID(pAccountIdentifier.Address)
+	stakeRewardAddr, _ := address.ParseToID(stakeRewardAccount.Address)
+	ownerIDs := []ids.ShortID{pAddr, stakeRewardAddr}
+	ids.SortShortIDs(ownerIDs)
+	owners := []string{}
+	for _, ownerID := range ownerIDs {
+		owner, _ := address.Format(mapper.PChainNetworkIdentifier, constants.FujiHRP, ownerID[:])
+		owners = append(owners, owner)
+	}
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                opExportAvax,
+			Account:             &types.AccountIdentifier{Address: owners[0]},
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(-1_000_000_000)),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: coinID1},
+				CoinAction:     types.CoinSpent,
+			},
+			Metadata: map[string]interface{}{
+				"type":        opTypeInput,
+				"sig_indices": []interface{}{0.0, 1.0},
+				"locktime":    0.0,
+				"threshold":   2.0,
+				"owners":      []interface{}{owners[0], owners[1]},
+			},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                opExportAvax,
+			Account:             &types.AccountIdentifier{Address: owners[0]},
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(499_000_000)),
+			Metadata: map[string]interface{}{
+				"type":      opTypeOutput,
+				"locktime":  0.0,
+				"threshold": 2.0,
+				"owners":    []interface{}{owners[0], owners[1]},
+			},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 2},
+			Type:                opExportAvax,
+			Account:             cAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(500_000_000)),
+			Metadata: map[string]interface{}{
+				"type":      opTypeExport,
+				"threshold": 1.0,
+				"locktime":  0.0,
+			},
+		},
+	}
+
+	payloadsMetadata := map[string]interface{}{
+		"network_id":           float64(networkID),
+		"destination_chain":    "C",
+		"destination_chain_id": cChainID.String(),
+		"blockchain_id":        pChainID.String(),
+	}
+
+	signers := []*types.AccountIdentifier{{Address: owners[0]}, {Address: owners[1]}}
+
+	ctx := context.Background()
+	clientMock := &mocks.PChainClient{}
+	backend := NewBackend(clientMock, nil, avaxAssetID, pChainNetworkIdentifier)
+
+	t.Run("payloads endpoint requires signature indices of owners", func(t *testing.T) {
+		invalidInput := *operations[0]
+		invalidInput.Metadata = map[string]interface{}{
+			"type":        opTypeInput,
+			"sig_indices": []interface{}{0.0, 2.0},
+			"threshold":   2.0,
+			"owners":      []interface{}{owners[0], owners[1]},
+		}
+
+		_, err := backend.ConstructionPayloads(
+			ctx,
+			&types.ConstructionPayloadsRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Operations:        []*types.Operation{&invalidInput, operations[1], operations[2]},
+				Metadata:          payloadsMetadata,
+			},
+		)
+		assert.NotNil(t, err)
+	})
+
+	resp, err := backend.ConstructionPayloads(
+		ctx,
+		&types.ConstructionPayloadsRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			Operations:        operations,
+			Metadata:          payloadsMetadata,
+		},
+	)
+	assert.Nil(t, err)
+
+	t.Run("payloads endpoint returns a payload per signer", func(t *testing.T) {
+		assert.Len(t, resp.Payloads, 2)
+		for i, payload := range resp.Payloads {
+			assert.Equal(t, signers[i], payload.AccountIdentifier)
+		}
+	})
+
+	t.Run("parse endpoint (unsigned)", func(t *testing.T) {
+		parseResp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       resp.UnsignedTransaction,
+				Signed:            false,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Len(t, parseResp.Operations, 3)
+		assert.Equal(t, operations[1:], parseResp.Operations[1:])
+	})
+
+	signatures := []*types.Signature{}
+	for _, payload := range resp.Payloads {
+		signatures = append(signatures, &types.Signature{
+			SigningPayload: payload,
+			SignatureType:  types.EcdsaRecovery,
+			Bytes:          make([]byte, crypto.SECP256K1RSigLen),
+		})
+	}
+
+	t.Run("combine endpoint requires a signature per signer", func(t *testing.T) {
+		_, err := backend.ConstructionCombine(
+			ctx,
+			&types.ConstructionCombineRequest{
+				NetworkIdentifier:   pChainNetworkIdentifier,
+				UnsignedTransaction: resp.UnsignedTransaction,
+				Signatures:          signatures[:1],
+			},
+		)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("combine and parse endpoints (signed)", func(t *testing.T) {
+		combineResp, err := backend.ConstructionCombine(
+			ctx,
+			&types.ConstructionCombineRequest{
+				NetworkIdentifier:   pChainNetworkIdentifier,
+				UnsignedTransaction: resp.UnsignedTransaction,
+				Signatures:          signatures,
+			},
+		)
+		assert.Nil(t, err)
+
+		parseResp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       combineResp.SignedTransaction,
+				Signed:            true,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, signers, parseResp.AccountIdentifierSigners)
+	})
+}
+
+func TestLockedAddDelegatorTxConstruction(t *testing.T) {
+	opAddDelegator := "ADD_DELEGATOR"
+	startTime := uint64(1659592163)
+	endTime := startTime + 14*86400
+	stakeableLocktime := endTime + 86400
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                opAddDelegator,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(-25_000_000_000)),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: coinID1},
+				CoinAction:     "coin_spent",
+			},
+			Metadata: map[string]interface{}{
+				"type":               opTypeInput,
+				"sig_indices":        []interface{}{0.0},
+				"locktime":           0.0,
+				"stakeable_locktime": float64(stakeableLocktime),
+			},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                opAddDelegator,
+			Account:             pAccountIdentifier,
+			Amount:              mapper.AtomicAvaxAmount(big.NewInt(25_000_000_000)),
+			Metadata: map[string]interface{}{
+				"type":               opTypeStake,
+				"locktime":           0.0,
+				"threshold":          1.0,
+				"stakeable_locktime": float64(stakeableLocktime),
+				// the following are ignored by payloads endpoint but generated by parse
+				// added here so that we can simply compare with parse outputs
+				"staking_start_time": startTime,
+				"staking_end_time":   endTime,
+				"validator_node_id":  nodeID,
+			},
+		},
+	}
+
+	payloadsMetadata := map[string]interface{}{
+		"network_id":       float64(networkID),
+		"blockchain_id":    pChainID.String(),
+		"node_id":          nodeID,
+		"start":            float64(startTime),
+		"end":              float64(endTime),
+		"shares":           0.0,
+		"locktime":         0.0,
+		"threshold":        1.0,
+		"memo":             "",
+		"reward_addresses": []interface{}{stakeRewardAccount.Address},
+	}
+
+	ctx := context.Background()
+	clientMock := &mocks.PChainClient{}
+	backend := NewBackend(clientMock, nil, avaxAssetID, pChainNetworkIdentifier)
+
+	resp, err := backend.ConstructionPayloads(
+		ctx,
+		&types.ConstructionPayloadsRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			Operations:        operations,
+			Metadata:          payloadsMetadata,
+		},
+	)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Payloads, 1)
+
+	t.Run("locked funds are spent and staked with their stakeable locktime", func(t *testing.T) {
+		rosettaTx, err := backend.parsePayloadTxFromString(resp.UnsignedTransaction)
+		assert.Nil(t, err)
+
+		tx, ok := rosettaTx.Tx.(*pTx).Tx.Unsigned.(*txs.AddDelegatorTx)
+		assert.True(t, ok)
+		lockIn, ok := tx.Ins[0].In.(*stakeable.LockIn)
+		assert.True(t, ok)
+		assert.Equal(t, stakeableLocktime, lockIn.Locktime)
+		lockOut, ok := tx.StakeOuts[0].Out.(*stakeable.LockOut)
+		assert.True(t, ok)
+		assert.Equal(t, stakeableLocktime, lockOut.Locktime)
+	})
+
+	t.Run("parse endpoint (unsigned)", func(t *testing.T) {
+		parseResp, err := backend.ConstructionParse(
+			ctx,
+			&types.ConstructionParseRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				Transaction:       resp.UnsignedTransaction,
+				Signed:            false,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, operations, parseResp.Operations)
+	})
+
+	t.Run("combine endpoint", func(t *testing.T) {
+		_, err := backend.ConstructionCombine(
+			ctx,
+			&types.ConstructionCombineRequest{
+				NetworkIdentifier:   pChainNetworkIdentifier,
+				UnsignedTransaction: resp.UnsignedTransaction,
+				Signatures: []*types.Signature{{
+					SigningPayload: resp.Payloads[0],
+					SignatureType:  types.EcdsaRecovery,
+					Bytes:          make([]byte, crypto.SECP256K1RSigLen),
+				}},
+			},
+		)
+		assert.Nil(t, err)
+	})
 }
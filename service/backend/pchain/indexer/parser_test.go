@@ -32,6 +32,11 @@ var (
 // idxs of the containers we test against
 var idxs = []uint64{0, 1, 2, 8, 48, 173, 382, 911, 1603, 5981, 131475, 211277, 211333, 806002, 810424, 1000000, 1000001, 1000002, 1000004} //nolint:lll
 
+// idxs of the built containers we test against, holding a CreateSubnetTx, a
+// CreateChainTx, an AddSubnetValidatorTx, a RemoveSubnetValidatorTx and a
+// TransformSubnetTx in blueberry standard blocks
+var subnetIdxs = []uint64{2000000, 2000001, 2000002, 2000003, 2000004}
+
 // mainnet block 1 container bytes
 // parent id is 2FUFPVPxbTpKNn39moGSzsmGroYES4NZRdw3mJgNvMkMiMHJ9e which is the mainnet genesis block id
 var genesisContainerBytes, _ = formatting.Decode(formatting.Hex, "0x000000000000a48d314805d44175be879e110a552187085ceb3611be6a43acd1dba798ae2427000000000000000100000013000000005f695aa000000000a89d88b0")
@@ -55,7 +60,7 @@ func TestMain(m *testing.M) {
 	pchainClient.On("GetContainerByIndex", mock.Anything, uint64(0)).
 		Return(indexer.Container{Bytes: genesisContainerBytes}, nil).Once()
 
-	for _, idx := range idxs {
+	for _, idx := range append(idxs, subnetIdxs...) {
 		ret := readFixture("ins/%v.json", idx)
 
 		var container indexer.Container
@@ -152,7 +157,7 @@ func TestFixtures(t *testing.T) {
 	ctx := context.Background()
 	a := assert.New(t)
 
-	for _, idx := range idxs {
+	for _, idx := range append(idxs, subnetIdxs...) {
 		// +1 because we do -1 inside parseBlockAtIndex
 		// and ins/outs are based on container ids
 		// instead of block ids
@@ -0,0 +1,163 @@
+package pchain
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+// issuedTxTTL is how long issued transactions are tracked. Transactions still
+// processing after it are considered dropped, which bounds the tracked
+// transactions of instances that never query their mempool.
+var issuedTxTTL = 30 * time.Minute
+
+// issuedTx is a transaction issued through this backend
+type issuedTx struct {
+	tx       *txs.Tx
+	issuedAt time.Time
+}
+
+// Mempool implements /mempool endpoint for P-chain
+//
+// The P-chain API has no way of listing the mempool of a node, so only the
+// transactions issued through /construction/submit of this instance that are
+// still processing are reported.
+func (b *Backend) Mempool(ctx context.Context, req *types.NetworkRequest) (*types.MempoolResponse, *types.Error) {
+	txIDs, err := b.processingTxIDs(ctx)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+
+	identifiers := make([]*types.TransactionIdentifier, 0, len(txIDs))
+	for _, txID := range txIDs {
+		identifiers = append(identifiers, &types.TransactionIdentifier{Hash: txID.String()})
+	}
+
+	return &types.MempoolResponse{
+		TransactionIdentifiers: identifiers,
+	}, nil
+}
+
+// MempoolTransaction implements /mempool/transaction endpoint for P-chain
+func (b *Backend) MempoolTransaction(
+	ctx context.Context,
+	req *types.MempoolTransactionRequest,
+) (*types.MempoolTransactionResponse, *types.Error) {
+	txID, err := ids.FromString(req.TransactionIdentifier.Hash)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	tx, ok := b.getIssuedTx(txID)
+	if !ok {
+		return nil, service.ErrTransactionNotFound
+	}
+
+	processing, err := b.isProcessing(ctx, txID)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+	if !processing {
+		b.untrackIssuedTx(txID)
+		return nil, service.WrapError(service.ErrTransactionNotFound, "transaction is not in the mempool")
+	}
+
+	transactions, err := b.parseTransactions(ctx, req.NetworkIdentifier, []*txs.Tx{tx})
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+
+	// Operations of a transaction which is not yet accepted have no status
+	transaction := transactions[0]
+	for _, op := range transaction.Operations {
+		op.Status = nil
+	}
+
+	return &types.MempoolTransactionResponse{
+		Transaction: transaction,
+	}, nil
+}
+
+// processingTxIDs returns the IDs of the issued transactions the node still
+// reports as processing, sorted. Decided and dropped transactions are no
+// longer tracked afterwards.
+func (b *Backend) processingTxIDs(ctx context.Context) ([]ids.ID, error) {
+	b.issuedTxsLock.Lock()
+	b.pruneIssuedTxs()
+	txIDs := make([]ids.ID, 0, len(b.issuedTxs))
+	for txID := range b.issuedTxs {
+		txIDs = append(txIDs, txID)
+	}
+	b.issuedTxsLock.Unlock()
+
+	processingTxIDs := make([]ids.ID, 0, len(txIDs))
+	for _, txID := range txIDs {
+		processing, err := b.isProcessing(ctx, txID)
+		if err != nil {
+			return nil, err
+		}
+		if !processing {
+			b.untrackIssuedTx(txID)
+			continue
+		}
+		processingTxIDs = append(processingTxIDs, txID)
+	}
+
+	sort.Slice(processingTxIDs, func(i, j int) bool {
+		return processingTxIDs[i].String() < processingTxIDs[j].String()
+	})
+
+	return processingTxIDs, nil
+}
+
+func (b *Backend) isProcessing(ctx context.Context, txID ids.ID) (bool, error) {
+	resp, err := b.pClient.GetTxStatus(ctx, txID)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Status == status.Processing, nil
+}
+
+func (b *Backend) trackIssuedTx(txID ids.ID, tx *txs.Tx) {
+	b.issuedTxsLock.Lock()
+	defer b.issuedTxsLock.Unlock()
+
+	b.pruneIssuedTxs()
+	b.issuedTxs[txID] = &issuedTx{tx: tx, issuedAt: time.Now()}
+}
+
+// pruneIssuedTxs stops tracking the transactions issued more than issuedTxTTL
+// ago. It must be called with issuedTxsLock held.
+func (b *Backend) pruneIssuedTxs() {
+	for txID, issued := range b.issuedTxs {
+		if time.Since(issued.issuedAt) > issuedTxTTL {
+			delete(b.issuedTxs, txID)
+		}
+	}
+}
+
+func (b *Backend) untrackIssuedTx(txID ids.ID) {
+	b.issuedTxsLock.Lock()
+	defer b.issuedTxsLock.Unlock()
+
+	delete(b.issuedTxs, txID)
+}
+
+func (b *Backend) getIssuedTx(txID ids.ID) (*txs.Tx, bool) {
+	b.issuedTxsLock.Lock()
+	defer b.issuedTxsLock.Unlock()
+
+	issued, ok := b.issuedTxs[txID]
+	if !ok || time.Since(issued.issuedAt) > issuedTxTTL {
+		return nil, false
+	}
+	return issued.tx, true
+}
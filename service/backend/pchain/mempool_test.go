@@ -0,0 +1,154 @@
+package pchain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	"github.com/ava-labs/avalanche-rosetta/service"
+
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+)
+
+func buildMempoolImportTx(t *testing.T) *txs.Tx {
+	_, _, addr, err := address.Parse(pAccountIdentifier.Address)
+	assert.Nil(t, err)
+	addrID, err := ids.ToShortID(addr)
+	assert.Nil(t, err)
+
+	utxoTxID, _ := ids.FromString(coinID1[:len(coinID1)-2])
+	return &txs.Tx{Unsigned: &txs.ImportTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    uint32(networkID),
+			BlockchainID: pChainID,
+			Outs: []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 999_000_000,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{addrID},
+					},
+				},
+			}},
+		}},
+		SourceChain: cChainID,
+		ImportedInputs: []*avax.TransferableInput{{
+			UTXOID: avax.UTXOID{TxID: utxoTxID},
+			Asset:  avax.Asset{ID: avaxAssetID},
+			In: &secp256k1fx.TransferInput{
+				Amt:   1_000_000_000,
+				Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+		}},
+	}}
+}
+
+func TestMempool(t *testing.T) {
+	ctx := context.Background()
+	clientMock := &mocks.PChainClient{}
+	backend := NewBackend(clientMock, nil, avaxAssetID, pChainNetworkIdentifier)
+
+	tx := buildMempoolImportTx(t)
+	assert.Nil(t, backend.initializeTx(tx))
+	backend.trackIssuedTx(tx.ID(), tx)
+
+	decidedTxID := ids.GenerateTestID()
+	backend.trackIssuedTx(decidedTxID, tx)
+
+	t.Run("lists processing transactions only", func(t *testing.T) {
+		clientMock.On("GetTxStatus", ctx, tx.ID()).Return(&platformvm.GetTxStatusResponse{Status: status.Processing}, nil).Once()
+		clientMock.On("GetTxStatus", ctx, decidedTxID).Return(&platformvm.GetTxStatusResponse{Status: status.Committed}, nil).Once()
+
+		resp, err := backend.Mempool(ctx, &types.NetworkRequest{NetworkIdentifier: pChainNetworkIdentifier})
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.TransactionIdentifier{{Hash: tx.ID().String()}}, resp.TransactionIdentifiers)
+
+		_, tracked := backend.getIssuedTx(decidedTxID)
+		assert.False(t, tracked)
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		clientMock.On("GetTxStatus", ctx, tx.ID()).Return(nil, errors.New("unavailable")).Once()
+
+		resp, err := backend.Mempool(ctx, &types.NetworkRequest{NetworkIdentifier: pChainNetworkIdentifier})
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrClientError.Code, err.Code)
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("parses processing transaction", func(t *testing.T) {
+		clientMock.On("GetTxStatus", ctx, tx.ID()).Return(&platformvm.GetTxStatusResponse{Status: status.Processing}, nil).Once()
+		clientMock.On("GetBlockchainID", ctx, mapper.CChainNetworkIdentifier).Return(cChainID, nil).Once()
+		clientMock.On("GetBlockchainID", ctx, mapper.XChainNetworkIdentifier).Return(ids.GenerateTestID(), nil).Once()
+
+		resp, err := backend.MempoolTransaction(ctx, &types.MempoolTransactionRequest{
+			NetworkIdentifier:     pChainNetworkIdentifier,
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: tx.ID().String()},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, tx.ID().String(), resp.Transaction.TransactionIdentifier.Hash)
+		// Imported inputs are only mapped to operations once accepted
+		assert.Len(t, resp.Transaction.Operations, 1)
+		op := resp.Transaction.Operations[0]
+		assert.Equal(t, pmapper.OpImportAvax, op.Type)
+		assert.Nil(t, op.Status)
+		assert.Equal(t, pAccountIdentifier, op.Account)
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("decided transaction is not in the mempool", func(t *testing.T) {
+		clientMock.On("GetTxStatus", ctx, tx.ID()).Return(&platformvm.GetTxStatusResponse{Status: status.Committed}, nil).Once()
+
+		resp, err := backend.MempoolTransaction(ctx, &types.MempoolTransactionRequest{
+			NetworkIdentifier:     pChainNetworkIdentifier,
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: tx.ID().String()},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrTransactionNotFound.Code, err.Code)
+
+		_, tracked := backend.getIssuedTx(tx.ID())
+		assert.False(t, tracked)
+		clientMock.AssertExpectations(t)
+	})
+
+	t.Run("unknown transaction", func(t *testing.T) {
+		resp, err := backend.MempoolTransaction(ctx, &types.MempoolTransactionRequest{
+			NetworkIdentifier:     pChainNetworkIdentifier,
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: ids.GenerateTestID().String()},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrTransactionNotFound, err)
+	})
+
+	t.Run("expired transactions are no longer tracked", func(t *testing.T) {
+		ttl := issuedTxTTL
+		defer func() { issuedTxTTL = ttl }()
+
+		expiredTxID := ids.GenerateTestID()
+		backend.trackIssuedTx(expiredTxID, tx)
+		issuedTxTTL = 0
+
+		_, tracked := backend.getIssuedTx(expiredTxID)
+		assert.False(t, tracked)
+
+		backend.trackIssuedTx(ids.GenerateTestID(), tx)
+		backend.issuedTxsLock.Lock()
+		_, tracked = backend.issuedTxs[expiredTxID]
+		backend.issuedTxsLock.Unlock()
+		assert.False(t, tracked)
+	})
+}
@@ -73,7 +73,7 @@ func (b *Backend) NetworkOptions(ctx context.Context, request *types.NetworkRequ
 			OperationTypes:          pmapper.OperationTypes,
 			CallMethods:             pmapper.CallMethods,
 			Errors:                  service.Errors,
-			HistoricalBalanceLookup: false,
+			HistoricalBalanceLookup: b.utxoIndex != nil,
 		},
 	}, nil
 }
@@ -0,0 +1,331 @@
+package pchain
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	"github.com/ava-labs/avalanche-rosetta/service"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/common"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/pchain/utxoindex"
+)
+
+// utxoIndexSyncInterval is the delay between two syncs of the UTXO index with the chain tip
+const utxoIndexSyncInterval = 5 * time.Second
+
+var errStakedOverflow = errors.New("overflow while calculating staked balance")
+
+// EnableUTXOIndex makes the backend answer historical /account/balance and
+// /account/coins requests using [utxoIndex], which is kept up to date by SyncUTXOIndex
+func (b *Backend) EnableUTXOIndex(utxoIndex *utxoindex.Index) {
+	b.utxoIndex = utxoIndex
+}
+
+// SyncUTXOIndex indexes the accepted P-chain blocks, from genesis up to the
+// chain tip, until [ctx] is done
+func (b *Backend) SyncUTXOIndex(ctx context.Context) {
+	for {
+		if err := b.syncUTXOIndex(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("p-chain utxo index sync failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(utxoIndexSyncInterval):
+		}
+	}
+}
+
+func (b *Backend) syncUTXOIndex(ctx context.Context) error {
+	genesisBlock, err := b.getGenesisBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastHeight, ok, err := b.utxoIndex.LastHeight()
+	if err != nil {
+		return err
+	}
+	height := genesisBlock.Height
+	if ok {
+		height = lastHeight + 1
+	}
+
+	tip, err := b.indexerParser.GetPlatformHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	for ; height <= tip; height++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		block, err := b.getUTXOIndexBlock(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		if err := b.utxoIndex.Apply(block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *Backend) getUTXOIndexBlock(ctx context.Context, height uint64) (*utxoindex.Block, error) {
+	if height == b.genesisBlock.Height {
+		genesisBlock, transactions, err := b.getGenesisBlockAndTransactions(ctx, b.networkIdentifier)
+		if err != nil {
+			return nil, err
+		}
+
+		return &utxoindex.Block{
+			Height:       genesisBlock.Height,
+			Hash:         genesisBlock.BlockID.String(),
+			Timestamp:    genesisBlock.Timestamp,
+			Transactions: transactions,
+		}, nil
+	}
+
+	block, err := b.indexerParser.ParseBlockAtIndex(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := b.parseTransactions(ctx, b.networkIdentifier, block.Txs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stake outputs are returned to their owners when the staker is removed
+	// from the validator set, which is when its reward tx is accepted
+	rewardedStakingTxIDs := []ids.ID{}
+	for _, tx := range block.Txs {
+		if rewardTx, ok := tx.Unsigned.(*txs.RewardValidatorTx); ok {
+			rewardedStakingTxIDs = append(rewardedStakingTxIDs, rewardTx.TxID)
+		}
+	}
+
+	return &utxoindex.Block{
+		Height:               block.Height,
+		Hash:                 block.BlockID.String(),
+		Timestamp:            block.Timestamp,
+		Transactions:         transactions,
+		RewardedStakingTxIDs: rewardedStakingTxIDs,
+	}, nil
+}
+
+func (b *Backend) indexedAccountBalance(
+	addr string,
+	balanceType string,
+	blockIdentifier *types.PartialBlockIdentifier,
+) (*types.AccountBalanceResponse, *types.Error) {
+	if balanceType == pmapper.SubAccountTypeSharedMemory {
+		return nil, service.WrapError(service.ErrNotSupported, "historical shared memory balances are not supported")
+	}
+	if balanceType == pmapper.SubAccountTypeMultisig {
+		return nil, service.WrapError(service.ErrNotSupported, "historical multisig balances are not supported")
+	}
+
+	block, utxos, typedErr := b.getIndexedUTXOs(addr, blockIdentifier)
+	if typedErr != nil {
+		return nil, typedErr
+	}
+
+	balance, err := b.getIndexedBalance(block, utxos)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+
+	balanceValue, typedErr := getBalanceOfType(balance, balanceType)
+	if typedErr != nil {
+		return nil, typedErr
+	}
+
+	return &types.AccountBalanceResponse{
+		BlockIdentifier: &types.BlockIdentifier{
+			Index: int64(block.Height),
+			Hash:  block.Hash,
+		},
+		Balances: []*types.Amount{
+			{
+				Value:    strconv.FormatUint(balanceValue, 10),
+				Currency: mapper.AtomicAvaxCurrency,
+			},
+		},
+	}, nil
+}
+
+func (b *Backend) indexedAccountCoins(
+	addr string,
+	subAccount string,
+	hrp string,
+	currencyAssetIDs map[ids.ID]struct{},
+	blockIdentifier *types.PartialBlockIdentifier,
+) (*types.AccountCoinsResponse, *types.Error) {
+	block, indexedUTXOs, typedErr := b.getIndexedUTXOs(addr, blockIdentifier)
+	if typedErr != nil {
+		return nil, typedErr
+	}
+
+	// Staked outputs are not part of the coins until they are returned to their owners
+	utxos := []avax.UTXO{}
+	for _, indexedUTXO := range indexedUTXOs {
+		if indexedUTXO.IsStakedAt(block.Height) {
+			continue
+		}
+
+		utxo, err := b.toAvaxUTXO(indexedUTXO)
+		if err != nil {
+			return nil, service.WrapError(service.ErrInternalError, err)
+		}
+		utxos = append(utxos, utxo)
+	}
+
+	blockTime := uint64(time.UnixMilli(block.Timestamp).Unix())
+	utxos = filterUTXOsByLockState(utxos, subAccount, blockTime)
+
+	coins, err := b.processUtxos(currencyAssetIDs, utxos)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+
+	metadata, err := buildCoinsMetadata(coins, utxos, hrp)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+
+	return &types.AccountCoinsResponse{
+		BlockIdentifier: &types.BlockIdentifier{
+			Index: int64(block.Height),
+			Hash:  block.Hash,
+		},
+		Coins:    common.SortUnique(coins),
+		Metadata: metadata,
+	}, nil
+}
+
+func (b *Backend) getIndexedUTXOs(
+	addr string,
+	blockIdentifier *types.PartialBlockIdentifier,
+) (*utxoindex.BlockInfo, []*utxoindex.UTXO, *types.Error) {
+	if b.utxoIndex == nil {
+		return nil, nil, service.WrapError(service.ErrNotSupported, "historical balance lookups are not supported")
+	}
+
+	// Addresses are indexed as formatted by the tx parser for this network
+	addrID, err := address.ParseToID(addr)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInvalidInput, "unable to convert address")
+	}
+	hrp, err := mapper.GetHRP(b.networkIdentifier)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInternalError, err)
+	}
+	addr, err = address.Format(mapper.PChainNetworkIdentifier, hrp, addrID[:])
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInvalidInput, "unable to convert address")
+	}
+
+	block, err := b.utxoIndex.Block(blockIdentifier)
+	if errors.Is(err, utxoindex.ErrNotIndexed) {
+		return nil, nil, service.WrapError(service.ErrBlockNotFound, err)
+	}
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	utxos, err := b.utxoIndex.UTXOs(addr, block.Height)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInternalError, err)
+	}
+
+	return block, utxos, nil
+}
+
+// getIndexedBalance classifies the indexed UTXOs of an account the same way
+// as fetchBalance, using the block timestamp instead of the current time
+func (b *Backend) getIndexedBalance(block *utxoindex.BlockInfo, indexedUTXOs []*utxoindex.UTXO) (*AccountBalance, error) {
+	staked := uint64(0)
+	utxos := []avax.UTXO{}
+	for _, indexedUTXO := range indexedUTXOs {
+		if indexedUTXO.IsStakedAt(block.Height) {
+			newStaked, err := math.Add64(staked, indexedUTXO.Amount)
+			if err != nil {
+				return nil, errStakedOverflow
+			}
+			staked = newStaked
+			continue
+		}
+
+		utxo, err := b.toAvaxUTXO(indexedUTXO)
+		if err != nil {
+			return nil, err
+		}
+		utxos = append(utxos, utxo)
+	}
+
+	blockTime := uint64(time.UnixMilli(block.Timestamp).Unix())
+	balance, err := b.getBalancesWithoutMultisig(utxos, blockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := math.Add64(balance.Total, staked)
+	if err != nil {
+		return nil, errTotalOverflow
+	}
+	balance.Staked = staked
+	balance.Total = total
+
+	return balance, nil
+}
+
+func (b *Backend) toAvaxUTXO(indexedUTXO *utxoindex.UTXO) (avax.UTXO, error) {
+	utxoID, err := mapper.DecodeUTXOID(indexedUTXO.ID)
+	if err != nil {
+		return avax.UTXO{}, err
+	}
+
+	addr, err := address.ParseToID(indexedUTXO.Address)
+	if err != nil {
+		return avax.UTXO{}, err
+	}
+
+	var out avax.TransferableOut = &secp256k1fx.TransferOutput{
+		Amt: indexedUTXO.Amount,
+		OutputOwners: secp256k1fx.OutputOwners{
+			Locktime:  indexedUTXO.Locktime,
+			Threshold: indexedUTXO.Threshold,
+			Addrs:     []ids.ShortID{addr},
+		},
+	}
+	if indexedUTXO.StakeableLocktime > 0 {
+		out = &stakeable.LockOut{
+			Locktime:        indexedUTXO.StakeableLocktime,
+			TransferableOut: out,
+		}
+	}
+
+	return avax.UTXO{
+		UTXOID: *utxoID,
+		Asset:  avax.Asset{ID: b.avaxAssetID},
+		Out:    out,
+	}, nil
+}
@@ -0,0 +1,272 @@
+package pchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+	idxmocks "github.com/ava-labs/avalanche-rosetta/mocks/service/backend/pchain/indexer"
+	"github.com/ava-labs/avalanche-rosetta/service"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/pchain/indexer"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/pchain/utxoindex"
+)
+
+func TestUTXOIndex(t *testing.T) {
+	ctx := context.Background()
+	pChainNetworkIdentifier := &types.NetworkIdentifier{
+		Network: mapper.FujiNetwork,
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{
+			Network: mapper.PChainNetworkIdentifier,
+		},
+	}
+
+	addr, err := address.ParseToID(pChainAddr)
+	assert.Nil(t, err)
+	owners := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}}
+	fujiHRP, err := mapper.GetHRP(pChainNetworkIdentifier)
+	assert.Nil(t, err)
+	fujiAddr, err := address.Format(mapper.PChainNetworkIdentifier, fujiHRP, addr[:])
+	assert.Nil(t, err)
+
+	genesisBlockID := ids.GenerateTestID()
+	genesisBlock := &indexer.ParsedGenesisBlock{
+		ParsedBlock: indexer.ParsedBlock{
+			BlockID:   genesisBlockID,
+			Height:    0,
+			Timestamp: 1_000_000,
+		},
+		GenesisBlockData: indexer.GenesisBlockData{
+			UTXOs: []*genesis.UTXO{
+				{UTXO: avax.UTXO{
+					UTXOID: avax.UTXOID{OutputIndex: 0},
+					Out:    &secp256k1fx.TransferOutput{Amt: 100, OutputOwners: owners},
+				}},
+				{UTXO: avax.UTXO{
+					UTXOID: avax.UTXOID{OutputIndex: 1},
+					Out: &stakeable.LockOut{
+						Locktime:        2000,
+						TransferableOut: &secp256k1fx.TransferOutput{Amt: 50, OutputOwners: owners},
+					},
+				}},
+				{UTXO: avax.UTXO{
+					UTXOID: avax.UTXOID{OutputIndex: 2},
+					Out: &secp256k1fx.TransferOutput{Amt: 25, OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Locktime:  5000,
+						Addrs:     []ids.ShortID{addr},
+					}},
+				}},
+			},
+		},
+	}
+
+	block1ID := ids.GenerateTestID()
+	block1 := &indexer.ParsedBlock{
+		BlockID:   block1ID,
+		ParentID:  genesisBlockID,
+		Height:    1,
+		Timestamp: 3_000_000,
+		Txs:       []*txs.Tx{{Unsigned: &txs.AdvanceTimeTx{Time: 3000}}},
+	}
+
+	pChainMock := &mocks.PChainClient{}
+	pChainMock.Mock.On("GetBlockchainID", ctx, mapper.CChainNetworkIdentifier).Return(ids.GenerateTestID(), nil).Once()
+	pChainMock.Mock.On("GetBlockchainID", ctx, mapper.XChainNetworkIdentifier).Return(ids.GenerateTestID(), nil).Once()
+	parserMock := &idxmocks.Parser{}
+	parserMock.Mock.On("GetGenesisBlock", ctx).Return(genesisBlock, nil).Once()
+	parserMock.Mock.On("GetPlatformHeight", ctx).Return(uint64(1), nil).Once()
+	parserMock.Mock.On("ParseBlockAtIndex", ctx, uint64(1)).Return(block1, nil).Once()
+
+	backend := NewBackend(pChainMock, parserMock, avaxAssetID, pChainNetworkIdentifier)
+	utxoIndex := utxoindex.New(memdb.New())
+
+	t.Run("historical lookups are not supported without index", func(t *testing.T) {
+		_, err := backend.AccountBalance(ctx, &types.AccountBalanceRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{Address: pChainAddr},
+			BlockIdentifier:   &types.PartialBlockIdentifier{Index: types.Int64(0)},
+		})
+		assert.Equal(t, service.ErrNotSupported.Code, err.Code)
+
+		resp, _ := backend.NetworkOptions(ctx, &types.NetworkRequest{NetworkIdentifier: pChainNetworkIdentifier})
+		assert.False(t, resp.Allow.HistoricalBalanceLookup)
+	})
+
+	t.Run("sync indexes blocks from genesis to tip", func(t *testing.T) {
+		backend.EnableUTXOIndex(utxoIndex)
+
+		assert.Nil(t, backend.syncUTXOIndex(ctx))
+
+		lastHeight, ok, err := utxoIndex.LastHeight()
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(1), lastHeight)
+
+		resp, _ := backend.NetworkOptions(ctx, &types.NetworkRequest{NetworkIdentifier: pChainNetworkIdentifier})
+		assert.True(t, resp.Allow.HistoricalBalanceLookup)
+
+		pChainMock.AssertExpectations(t)
+		parserMock.AssertExpectations(t)
+	})
+
+	// A staking tx spending the first genesis UTXO
+	stakingTxID := ids.GenerateTestID()
+	stakeMetadata, err := mapper.MarshalJSONMap(&pmapper.OperationMetadata{Type: pmapper.OpTypeStakeOutput, Threshold: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, utxoIndex.Apply(&utxoindex.Block{
+		Height:    2,
+		Hash:      ids.GenerateTestID().String(),
+		Timestamp: 4_000_000,
+		Transactions: []*types.Transaction{{
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: stakingTxID.String()},
+			Operations: []*types.Operation{
+				{
+					Account: &types.AccountIdentifier{Address: fujiAddr},
+					Amount:  mapper.AtomicAvaxAmount(big.NewInt(-100)),
+					CoinChange: &types.CoinChange{
+						CoinIdentifier: &types.CoinIdentifier{Identifier: ids.Empty.String() + ":0"},
+						CoinAction:     types.CoinSpent,
+					},
+				},
+				{
+					Account: &types.AccountIdentifier{Address: fujiAddr},
+					Amount:  mapper.AtomicAvaxAmount(big.NewInt(100)),
+					CoinChange: &types.CoinChange{
+						CoinIdentifier: &types.CoinIdentifier{Identifier: stakingTxID.String() + ":0"},
+						CoinAction:     types.CoinCreated,
+					},
+					Metadata: stakeMetadata,
+				},
+			},
+		}},
+	}))
+
+	t.Run("balances are returned at the requested height", func(t *testing.T) {
+		testData := []struct {
+			height          int64
+			hash            string
+			subAccount      string
+			expectedBalance string
+		}{
+			{0, genesisBlockID.String(), "", "175"},
+			{0, genesisBlockID.String(), pmapper.SubAccountTypeUnlocked, "100"},
+			{0, genesisBlockID.String(), pmapper.SubAccountTypeLockedStakeable, "50"},
+			{0, genesisBlockID.String(), pmapper.SubAccountTypeLockedNotStakeable, "25"},
+			{0, genesisBlockID.String(), pmapper.SubAccountTypeStaked, "0"},
+			// Stakeable lock expired by the block 1 timestamp
+			{1, block1ID.String(), pmapper.SubAccountTypeUnlocked, "150"},
+			{1, block1ID.String(), pmapper.SubAccountTypeLockedStakeable, "0"},
+			{2, "", "", "175"},
+			{2, "", pmapper.SubAccountTypeUnlocked, "50"},
+			{2, "", pmapper.SubAccountTypeStaked, "100"},
+		}
+
+		for _, tc := range testData {
+			var subAccount *types.SubAccountIdentifier
+			if tc.subAccount != "" {
+				subAccount = &types.SubAccountIdentifier{Address: tc.subAccount}
+			}
+
+			resp, err := backend.AccountBalance(ctx, &types.AccountBalanceRequest{
+				NetworkIdentifier: pChainNetworkIdentifier,
+				AccountIdentifier: &types.AccountIdentifier{Address: pChainAddr, SubAccount: subAccount},
+				BlockIdentifier:   &types.PartialBlockIdentifier{Index: types.Int64(tc.height)},
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, tc.height, resp.BlockIdentifier.Index)
+			if tc.hash != "" {
+				assert.Equal(t, tc.hash, resp.BlockIdentifier.Hash)
+			}
+			assert.Equal(t, tc.expectedBalance, resp.Balances[0].Value, "height %d sub-account %q", tc.height, tc.subAccount)
+		}
+	})
+
+	t.Run("balance lookups by hash", func(t *testing.T) {
+		resp, err := backend.AccountBalance(ctx, &types.AccountBalanceRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{Address: pChainAddr},
+			BlockIdentifier:   &types.PartialBlockIdentifier{Hash: types.String(block1ID.String())},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), resp.BlockIdentifier.Index)
+		assert.Equal(t, "175", resp.Balances[0].Value)
+	})
+
+	t.Run("blocks which are not indexed yet are not found", func(t *testing.T) {
+		_, err := backend.AccountBalance(ctx, &types.AccountBalanceRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{Address: pChainAddr},
+			BlockIdentifier:   &types.PartialBlockIdentifier{Index: types.Int64(3)},
+		})
+		assert.Equal(t, service.ErrBlockNotFound.Code, err.Code)
+	})
+
+	t.Run("shared memory balances are not supported", func(t *testing.T) {
+		_, err := backend.AccountBalance(ctx, &types.AccountBalanceRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address:    pChainAddr,
+				SubAccount: &types.SubAccountIdentifier{Address: pmapper.SubAccountTypeSharedMemory},
+			},
+			BlockIdentifier: &types.PartialBlockIdentifier{Index: types.Int64(0)},
+		})
+		assert.Equal(t, service.ErrNotSupported.Code, err.Code)
+	})
+
+	t.Run("coins are returned at the requested height", func(t *testing.T) {
+		coinIDs := func(coins []*types.Coin) []string {
+			result := []string{}
+			for _, coin := range coins {
+				result = append(result, coin.CoinIdentifier.Identifier)
+			}
+			return result
+		}
+
+		resp, err := backend.AccountCoins(ctx, &types.AccountCoinsRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: pChainAddr,
+				Metadata: map[string]interface{}{
+					"block_identifier": map[string]interface{}{"index": 0},
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, &types.BlockIdentifier{Index: 0, Hash: genesisBlockID.String()}, resp.BlockIdentifier)
+		assert.ElementsMatch(t, []string{
+			ids.Empty.String() + ":0",
+			ids.Empty.String() + ":1",
+			ids.Empty.String() + ":2",
+		}, coinIDs(resp.Coins))
+
+		// Staked outputs are not returned as coins
+		resp, err = backend.AccountCoins(ctx, &types.AccountCoinsRequest{
+			NetworkIdentifier: pChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: pChainAddr,
+				Metadata: map[string]interface{}{
+					"block_identifier": map[string]interface{}{"index": 2},
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{
+			ids.Empty.String() + ":1",
+			ids.Empty.String() + ":2",
+		}, coinIDs(resp.Coins))
+	})
+}
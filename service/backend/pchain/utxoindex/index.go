@@ -0,0 +1,346 @@
+package utxoindex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+)
+
+var (
+	ErrNotIndexed = errors.New("block is not indexed yet")
+
+	errUnexpectedHeight = errors.New("unexpected block height")
+	errMismatchedHeight = errors.New("provided block height does not match height of the block with given hash")
+	errMissingBlock     = errors.New("a block index, a block hash or both must be specified")
+
+	lastHeightKey = []byte("lastHeight")
+
+	metaPrefix        = []byte("meta")
+	blockPrefix       = []byte("block")
+	blockHeightPrefix = []byte("blockHeight")
+	utxoPrefix        = []byte("utxo")
+	addressPrefix     = []byte("address")
+	stakePrefix       = []byte("stake")
+)
+
+// Block holds the data of an accepted P-chain block which is relevant for the index
+type Block struct {
+	Height    uint64
+	Hash      string
+	Timestamp int64
+
+	// Transactions are the block transactions as parsed by pmapper.TxParser
+	Transactions []*types.Transaction
+
+	// RewardedStakingTxIDs are the ids of the staking txs whose staking period
+	// ended in this block. Their stake outputs are returned to their owners.
+	RewardedStakingTxIDs []ids.ID
+}
+
+// BlockInfo is the indexed identifier and timestamp of a block
+type BlockInfo struct {
+	Height    uint64 `json:"height"`
+	Hash      string `json:"hash"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// UTXO is a single-owner P-chain UTXO along with the heights it was created,
+// unstaked and spent at
+type UTXO struct {
+	ID                string `json:"id"`
+	Address           string `json:"address"`
+	Amount            uint64 `json:"amount"`
+	Locktime          uint64 `json:"locktime"`
+	StakeableLocktime uint64 `json:"stakeable_locktime,omitempty"`
+	Threshold         uint32 `json:"threshold"`
+
+	// Stake is true for stake outputs, which are staked until UnstakedHeight
+	Stake          bool    `json:"stake,omitempty"`
+	CreatedHeight  uint64  `json:"created_height"`
+	UnstakedHeight *uint64 `json:"unstaked_height,omitempty"`
+	SpentHeight    *uint64 `json:"spent_height,omitempty"`
+}
+
+// IsUnspentAt returns true if the UTXO exists at the given height
+func (u *UTXO) IsUnspentAt(height uint64) bool {
+	return u.CreatedHeight <= height && (u.SpentHeight == nil || *u.SpentHeight > height)
+}
+
+// IsStakedAt returns true if the UTXO is locked in a staking period at the given height
+func (u *UTXO) IsStakedAt(height uint64) bool {
+	return u.Stake && u.IsUnspentAt(height) && (u.UnstakedHeight == nil || *u.UnstakedHeight > height)
+}
+
+// Index is a persistent index of the P-chain UTXOs, built block by block,
+// which allows to look up the UTXOs of an address at any indexed height.
+type Index struct {
+	// lock serializes the writers, readers only see committed blocks
+	lock sync.Mutex
+	db   database.Database
+}
+
+// New returns an index backed by [db]
+func New(db database.Database) *Index {
+	return &Index{db: db}
+}
+
+// Open returns an index backed by a leveldb database in [dir]
+func Open(dir string) (*Index, error) {
+	db, err := leveldb.New(dir, nil, logging.NoLog{}, "utxoindex", prometheus.NewRegistry())
+	if err != nil {
+		return nil, err
+	}
+
+	return New(db), nil
+}
+
+// Close closes the underlying database
+func (i *Index) Close() error {
+	return i.db.Close()
+}
+
+// LastHeight returns the height of the last indexed block.
+// ok is false when no block has been indexed yet.
+func (i *Index) LastHeight() (height uint64, ok bool, err error) {
+	height, err = database.GetUInt64(prefixdb.New(metaPrefix, i.db), lastHeightKey)
+	if err == database.ErrNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return height, true, nil
+}
+
+// Apply indexes the UTXOs created and spent in [block].
+// Blocks must be applied in order, starting from the genesis block.
+func (i *Index) Apply(block *Block) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	lastHeight, ok, err := i.LastHeight()
+	if err != nil {
+		return err
+	}
+	if ok && block.Height != lastHeight+1 {
+		return fmt.Errorf("%w: expected %d, got %d", errUnexpectedHeight, lastHeight+1, block.Height)
+	}
+
+	// All changes of a block are committed at once so that readers
+	// never observe a partially indexed block
+	vdb := versiondb.New(i.db)
+	defer vdb.Abort()
+
+	blockBytes, err := json.Marshal(&BlockInfo{
+		Height:    block.Height,
+		Hash:      block.Hash,
+		Timestamp: block.Timestamp,
+	})
+	if err != nil {
+		return err
+	}
+	if err := prefixdb.New(blockPrefix, vdb).Put(database.PackUInt64(block.Height), blockBytes); err != nil {
+		return err
+	}
+	if err := database.PutUInt64(prefixdb.New(blockHeightPrefix, vdb), []byte(block.Hash), block.Height); err != nil {
+		return err
+	}
+
+	for _, tx := range block.Transactions {
+		for _, op := range tx.Operations {
+			if err := applyOperation(vdb, block.Height, op); err != nil {
+				return fmt.Errorf("failed to index tx %s: %w", tx.TransactionIdentifier.Hash, err)
+			}
+		}
+	}
+
+	for _, stakingTxID := range block.RewardedStakingTxIDs {
+		if err := unstake(vdb, block.Height, stakingTxID); err != nil {
+			return err
+		}
+	}
+
+	if err := database.PutUInt64(prefixdb.New(metaPrefix, vdb), lastHeightKey, block.Height); err != nil {
+		return err
+	}
+
+	return vdb.Commit()
+}
+
+func applyOperation(db database.Database, height uint64, op *types.Operation) error {
+	if op.CoinChange == nil || op.Account == nil {
+		return nil
+	}
+	utxoID := op.CoinChange.CoinIdentifier.Identifier
+
+	switch op.CoinChange.CoinAction {
+	case types.CoinCreated:
+		opMetadata, err := pmapper.ParseOpMetadata(op.Metadata)
+		if err != nil {
+			return err
+		}
+
+		amount, ok := new(big.Int).SetString(op.Amount.Value, 10)
+		if !ok || !amount.IsUint64() {
+			return fmt.Errorf("invalid amount %s", op.Amount.Value)
+		}
+
+		utxo := &UTXO{
+			ID:                utxoID,
+			Address:           op.Account.Address,
+			Amount:            amount.Uint64(),
+			Locktime:          opMetadata.Locktime,
+			StakeableLocktime: opMetadata.StakeableLocktime,
+			Threshold:         opMetadata.Threshold,
+			Stake:             opMetadata.Type == pmapper.OpTypeStakeOutput,
+			CreatedHeight:     height,
+		}
+		if err := putUTXO(db, utxo); err != nil {
+			return err
+		}
+		if err := addressDB(db, utxo.Address).Put([]byte(utxoID), nil); err != nil {
+			return err
+		}
+		if utxo.Stake {
+			decodedID, err := mapper.DecodeUTXOID(utxoID)
+			if err != nil {
+				return err
+			}
+			return stakeDB(db, decodedID.TxID).Put([]byte(utxoID), nil)
+		}
+		return nil
+	case types.CoinSpent:
+		utxo, err := getUTXO(db, utxoID)
+		if err == database.ErrNotFound {
+			// UTXOs which are not tracked, e.g. multisig ones, are not indexed
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		utxo.SpentHeight = &height
+		return putUTXO(db, utxo)
+	default:
+		return nil
+	}
+}
+
+func unstake(db database.Database, height uint64, stakingTxID ids.ID) error {
+	it := stakeDB(db, stakingTxID).NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		utxo, err := getUTXO(db, string(it.Key()))
+		if err != nil {
+			return err
+		}
+		utxo.UnstakedHeight = &height
+		if err := putUTXO(db, utxo); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// Block returns the indexed block matching [identifier]
+func (i *Index) Block(identifier *types.PartialBlockIdentifier) (*BlockInfo, error) {
+	if identifier.Index == nil && identifier.Hash == nil {
+		return nil, errMissingBlock
+	}
+
+	var height uint64
+	if identifier.Index != nil {
+		height = uint64(*identifier.Index)
+	}
+
+	if identifier.Hash != nil {
+		hashHeight, err := database.GetUInt64(prefixdb.New(blockHeightPrefix, i.db), []byte(*identifier.Hash))
+		if err == database.ErrNotFound {
+			return nil, ErrNotIndexed
+		}
+		if err != nil {
+			return nil, err
+		}
+		if identifier.Index != nil && hashHeight != height {
+			return nil, errMismatchedHeight
+		}
+		height = hashHeight
+	}
+
+	blockBytes, err := prefixdb.New(blockPrefix, i.db).Get(database.PackUInt64(height))
+	if err == database.ErrNotFound {
+		return nil, ErrNotIndexed
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	block := &BlockInfo{}
+	if err := json.Unmarshal(blockBytes, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// UTXOs returns the UTXOs of [address] which are unspent at [height]
+func (i *Index) UTXOs(address string, height uint64) ([]*UTXO, error) {
+	it := addressDB(i.db, address).NewIterator()
+	defer it.Release()
+
+	utxos := []*UTXO{}
+	for it.Next() {
+		utxo, err := getUTXO(i.db, string(it.Key()))
+		if err != nil {
+			return nil, err
+		}
+		if utxo.IsUnspentAt(height) {
+			utxos = append(utxos, utxo)
+		}
+	}
+
+	return utxos, it.Error()
+}
+
+func addressDB(db database.Database, address string) database.Database {
+	return prefixdb.New([]byte(address), prefixdb.New(addressPrefix, db))
+}
+
+func stakeDB(db database.Database, stakingTxID ids.ID) database.Database {
+	return prefixdb.New(stakingTxID[:], prefixdb.New(stakePrefix, db))
+}
+
+func getUTXO(db database.Database, utxoID string) (*UTXO, error) {
+	utxoBytes, err := prefixdb.New(utxoPrefix, db).Get([]byte(utxoID))
+	if err != nil {
+		return nil, err
+	}
+
+	utxo := &UTXO{}
+	if err := json.Unmarshal(utxoBytes, utxo); err != nil {
+		return nil, err
+	}
+	return utxo, nil
+}
+
+func putUTXO(db database.Database, utxo *UTXO) error {
+	utxoBytes, err := json.Marshal(utxo)
+	if err != nil {
+		return err
+	}
+	return prefixdb.New(utxoPrefix, db).Put([]byte(utxo.ID), utxoBytes)
+}
@@ -0,0 +1,204 @@
+package utxoindex
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+)
+
+var (
+	stakingTxID = ids.ID{1}
+	spendTxID   = ids.ID{2}
+
+	addr      = "P-fuji1qyqszqgpqyqszqgpqyqszqgpqyqszqgp2tdmlv"
+	otherAddr = "P-fuji1qgpqyqszqgpqyqszqgpqyqszqgpqyqszqgmljt5"
+)
+
+func createdOp(t *testing.T, utxoID string, address string, amount int64, opMetadata *pmapper.OperationMetadata) *types.Operation {
+	metadata, err := mapper.MarshalJSONMap(opMetadata)
+	assert.Nil(t, err)
+
+	return &types.Operation{
+		Account: &types.AccountIdentifier{Address: address},
+		Amount:  mapper.AtomicAvaxAmount(big.NewInt(amount)),
+		CoinChange: &types.CoinChange{
+			CoinIdentifier: &types.CoinIdentifier{Identifier: utxoID},
+			CoinAction:     types.CoinCreated,
+		},
+		Metadata: metadata,
+	}
+}
+
+func spentOp(utxoID string, address string, amount int64) *types.Operation {
+	return &types.Operation{
+		Account: &types.AccountIdentifier{Address: address},
+		Amount:  mapper.AtomicAvaxAmount(big.NewInt(-amount)),
+		CoinChange: &types.CoinChange{
+			CoinIdentifier: &types.CoinIdentifier{Identifier: utxoID},
+			CoinAction:     types.CoinSpent,
+		},
+	}
+}
+
+func tx(txID ids.ID, ops ...*types.Operation) *types.Transaction {
+	return &types.Transaction{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: txID.String()},
+		Operations:            ops,
+	}
+}
+
+func utxoIDs(utxos []*UTXO) []string {
+	result := []string{}
+	for _, utxo := range utxos {
+		result = append(result, utxo.ID)
+	}
+	return result
+}
+
+func TestIndex(t *testing.T) {
+	index := New(memdb.New())
+
+	genesisUTXO := ids.Empty.String() + ":0"
+	lockedUTXO := ids.Empty.String() + ":1"
+	changeUTXO := stakingTxID.String() + ":0"
+	stakeUTXO := stakingTxID.String() + ":1"
+	rewardUTXO := stakingTxID.String() + ":2"
+	spendUTXO := spendTxID.String() + ":0"
+
+	_, ok, err := index.LastHeight()
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	blocks := []*Block{
+		{
+			Height: 0,
+			Hash:   "genesis",
+			Transactions: []*types.Transaction{tx(
+				ids.Empty,
+				createdOp(t, genesisUTXO, addr, 100, &pmapper.OperationMetadata{Type: pmapper.OpTypeOutput, Threshold: 1}),
+				createdOp(t, lockedUTXO, addr, 50, &pmapper.OperationMetadata{
+					Type:              pmapper.OpTypeOutput,
+					Threshold:         1,
+					StakeableLocktime: 2000,
+				}),
+			)},
+		},
+		{
+			Height:    1,
+			Hash:      "block1",
+			Timestamp: 1000,
+			Transactions: []*types.Transaction{tx(
+				stakingTxID,
+				spentOp(genesisUTXO, addr, 100),
+				createdOp(t, changeUTXO, addr, 60, &pmapper.OperationMetadata{Type: pmapper.OpTypeOutput, Threshold: 1}),
+				createdOp(t, stakeUTXO, addr, 40, &pmapper.OperationMetadata{Type: pmapper.OpTypeStakeOutput, Threshold: 1}),
+			)},
+		},
+		{
+			Height:    2,
+			Hash:      "block2",
+			Timestamp: 2000,
+			Transactions: []*types.Transaction{tx(
+				ids.ID{3},
+				createdOp(t, rewardUTXO, addr, 5, &pmapper.OperationMetadata{Type: pmapper.OpTypeReward, Threshold: 1}),
+			)},
+			RewardedStakingTxIDs: []ids.ID{stakingTxID},
+		},
+		{
+			Height:    3,
+			Hash:      "block3",
+			Timestamp: 3000,
+			Transactions: []*types.Transaction{tx(
+				spendTxID,
+				spentOp(stakeUTXO, addr, 40),
+				createdOp(t, spendUTXO, otherAddr, 40, &pmapper.OperationMetadata{Type: pmapper.OpTypeOutput, Threshold: 1}),
+			)},
+		},
+	}
+
+	t.Run("blocks must be applied in order", func(t *testing.T) {
+		for _, block := range blocks {
+			assert.Nil(t, index.Apply(block))
+		}
+
+		err := index.Apply(&Block{Height: 5})
+		assert.ErrorIs(t, err, errUnexpectedHeight)
+
+		lastHeight, ok, err := index.LastHeight()
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(3), lastHeight)
+	})
+
+	t.Run("utxos are returned as of the requested height", func(t *testing.T) {
+		utxos, err := index.UTXOs(addr, 0)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{genesisUTXO, lockedUTXO}, utxoIDs(utxos))
+
+		utxos, err = index.UTXOs(addr, 1)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{lockedUTXO, changeUTXO, stakeUTXO}, utxoIDs(utxos))
+
+		utxos, err = index.UTXOs(addr, 2)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{lockedUTXO, changeUTXO, stakeUTXO, rewardUTXO}, utxoIDs(utxos))
+
+		utxos, err = index.UTXOs(addr, 3)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{lockedUTXO, changeUTXO, rewardUTXO}, utxoIDs(utxos))
+
+		utxos, err = index.UTXOs(otherAddr, 3)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{spendUTXO}, utxoIDs(utxos))
+
+		utxos, err = index.UTXOs(addr, 1)
+		assert.Nil(t, err)
+		for _, utxo := range utxos {
+			if utxo.ID == lockedUTXO {
+				assert.Equal(t, uint64(2000), utxo.StakeableLocktime)
+			}
+		}
+	})
+
+	t.Run("stake outputs are staked until rewarded", func(t *testing.T) {
+		utxos, err := index.UTXOs(addr, 2)
+		assert.Nil(t, err)
+
+		for _, utxo := range utxos {
+			if utxo.ID != stakeUTXO {
+				assert.False(t, utxo.IsStakedAt(1))
+				continue
+			}
+			assert.True(t, utxo.IsStakedAt(1))
+			assert.False(t, utxo.IsStakedAt(2))
+			assert.True(t, utxo.IsUnspentAt(2))
+			assert.False(t, utxo.IsUnspentAt(3))
+		}
+	})
+
+	t.Run("blocks are looked up by index or hash", func(t *testing.T) {
+		block, err := index.Block(&types.PartialBlockIdentifier{Index: types.Int64(2)})
+		assert.Nil(t, err)
+		assert.Equal(t, &BlockInfo{Height: 2, Hash: "block2", Timestamp: 2000}, block)
+
+		block, err = index.Block(&types.PartialBlockIdentifier{Hash: types.String("block1")})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), block.Height)
+
+		_, err = index.Block(&types.PartialBlockIdentifier{Index: types.Int64(1), Hash: types.String("block2")})
+		assert.ErrorIs(t, err, errMismatchedHeight)
+
+		_, err = index.Block(&types.PartialBlockIdentifier{Index: types.Int64(4)})
+		assert.ErrorIs(t, err, ErrNotIndexed)
+
+		_, err = index.Block(&types.PartialBlockIdentifier{Hash: types.String("unknown")})
+		assert.ErrorIs(t, err, ErrNotIndexed)
+	})
+}
@@ -0,0 +1,281 @@
+package xchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	"github.com/ava-labs/avalanche-rosetta/service"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/common"
+)
+
+var (
+	errUnableToGetUTXOs  = errors.New("unable to get UTXOs")
+	errUnableToParseUTXO = errors.New("unable to parse UTXO")
+)
+
+func (b *Backend) AccountBalance(ctx context.Context, req *types.AccountBalanceRequest) (*types.AccountBalanceResponse, *types.Error) {
+	if req.AccountIdentifier == nil {
+		return nil, service.WrapError(service.ErrInvalidInput, "account identifier is not provided")
+	}
+	if req.BlockIdentifier != nil {
+		return nil, service.WrapError(service.ErrNotSupported, "historical balance lookups are not supported")
+	}
+
+	blockIdentifier, coins, typedErr := b.fetchCoins(ctx, req.AccountIdentifier, req.Currencies)
+	if typedErr != nil {
+		return nil, typedErr
+	}
+
+	balances := map[string]*big.Int{}
+	currencies := map[string]*types.Currency{}
+	for _, coin := range coins {
+		key := types.Hash(coin.Amount.Currency)
+		value, err := types.AmountValue(coin.Amount)
+		if err != nil {
+			return nil, service.WrapError(service.ErrInternalError, err)
+		}
+
+		if _, ok := balances[key]; !ok {
+			balances[key] = new(big.Int)
+			currencies[key] = coin.Amount.Currency
+		}
+		balances[key].Add(balances[key], value)
+	}
+
+	// Requested currencies are returned even if the account holds none of them
+	for _, currency := range req.Currencies {
+		key := types.Hash(currency)
+		if _, ok := balances[key]; !ok {
+			balances[key] = new(big.Int)
+			currencies[key] = currency
+		}
+	}
+
+	keys := make([]string, 0, len(balances))
+	for key := range balances {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	amounts := make([]*types.Amount, 0, len(keys))
+	for _, key := range keys {
+		amounts = append(amounts, mapper.Amount(balances[key], currencies[key]))
+	}
+
+	return &types.AccountBalanceResponse{
+		BlockIdentifier: blockIdentifier,
+		Balances:        amounts,
+	}, nil
+}
+
+func (b *Backend) AccountCoins(ctx context.Context, req *types.AccountCoinsRequest) (*types.AccountCoinsResponse, *types.Error) {
+	if req.AccountIdentifier == nil {
+		return nil, service.WrapError(service.ErrInvalidInput, "account identifier is not provided")
+	}
+
+	blockIdentifier, coins, typedErr := b.fetchCoins(ctx, req.AccountIdentifier, req.Currencies)
+	if typedErr != nil {
+		return nil, typedErr
+	}
+
+	return &types.AccountCoinsResponse{
+		BlockIdentifier: blockIdentifier,
+		Coins:           common.SortUnique(coins),
+	}, nil
+}
+
+// Fetches the coins of the given account, optionally filtered by currencies.
+//
+// Since UTXO APIs don't return the corresponding block, the last accepted
+// tx is checked before and after and the fetch is retried if they differ.
+func (b *Backend) fetchCoins(
+	ctx context.Context,
+	account *types.AccountIdentifier,
+	currencies []*types.Currency,
+) (*types.BlockIdentifier, []*types.Coin, *types.Error) {
+	addr, err := address.ParseToID(account.Address)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInvalidInput, "unable to convert address")
+	}
+
+	currencyAssetIDs := make(map[ids.ID]struct{}, len(currencies))
+	for _, currency := range currencies {
+		assetID, err := xmapper.CurrencyAssetID(b.avaxAssetID, currency)
+		if err != nil {
+			return nil, nil, service.WrapError(service.ErrInvalidInput, err)
+		}
+		currencyAssetIDs[assetID] = struct{}{}
+	}
+
+	var sourceChains []string
+	switch {
+	case account.SubAccount == nil:
+		sourceChains = []string{""}
+	case account.SubAccount.Address == pmapper.SubAccountTypeSharedMemory:
+		sourceChains = []string{
+			mapper.PChainNetworkIdentifier,
+			mapper.CChainNetworkIdentifier,
+		}
+	default:
+		return nil, nil, service.WrapError(service.ErrInvalidInput, "unknown account type "+account.SubAccount.Address)
+	}
+
+	var (
+		blockIdentifier *types.BlockIdentifier
+		coins           []*types.Coin
+	)
+	typedErr := common.RetryOnChainAdvance(ctx, b.utxoFetchAttempts, func() *types.Error {
+		var typedErr *types.Error
+		blockIdentifier, coins, typedErr = b.fetchCoinsAtLastAccepted(ctx, addr, sourceChains, currencyAssetIDs)
+		return typedErr
+	})
+	if typedErr != nil {
+		return nil, nil, typedErr
+	}
+	return blockIdentifier, coins, nil
+}
+
+func (b *Backend) fetchCoinsAtLastAccepted(
+	ctx context.Context,
+	addr ids.ShortID,
+	sourceChains []string,
+	currencyAssetIDs map[ids.ID]struct{},
+) (*types.BlockIdentifier, []*types.Coin, *types.Error) {
+	// fetch the last accepted tx before the UTXO fetch
+	preContainer, err := b.xClient.GetLastAccepted(ctx)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrClientError, err)
+	}
+
+	var utxoBytes [][]byte
+	for _, sourceChain := range sourceChains {
+		chainUTXOBytes, err := b.getAccountUTXOs(ctx, addr, sourceChain)
+		if err != nil {
+			return nil, nil, service.WrapError(service.ErrInternalError, err)
+		}
+		utxoBytes = append(utxoBytes, chainUTXOBytes...)
+	}
+
+	// fetch the last accepted tx after the UTXO fetch and compare
+	postContainer, err := b.xClient.GetLastAccepted(ctx)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrClientError, err)
+	}
+	if preContainer.ID != postContainer.ID {
+		return nil, nil, service.WrapError(service.ErrChainAdvanced, "new block added while fetching utxos")
+	}
+
+	height, err := b.xClient.GetIndex(ctx, postContainer.ID)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrClientError, err)
+	}
+
+	coins, err := b.processUTXOs(ctx, currencyAssetIDs, utxoBytes)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInternalError, err)
+	}
+
+	return &types.BlockIdentifier{
+		Index: int64(height),
+		Hash:  postContainer.ID.String(),
+	}, coins, nil
+}
+
+func (b *Backend) getAccountUTXOs(ctx context.Context, addr ids.ShortID, sourceChain string) ([][]byte, error) {
+	utxos := [][]byte{}
+
+	// Used for pagination
+	var startAddr ids.ShortID
+	var startUTXOID ids.ID
+	for {
+		var utxoPage [][]byte
+		var err error
+
+		// GetUTXOs controlled by addr
+		if sourceChain == "" {
+			utxoPage, startAddr, startUTXOID, err = b.xClient.GetUTXOs(
+				ctx,
+				[]ids.ShortID{addr},
+				b.getUTXOsPageSize,
+				startAddr,
+				startUTXOID,
+			)
+		} else {
+			utxoPage, startAddr, startUTXOID, err = b.xClient.GetAtomicUTXOs(
+				ctx,
+				[]ids.ShortID{addr},
+				sourceChain,
+				b.getUTXOsPageSize,
+				startAddr,
+				startUTXOID,
+			)
+		}
+		if err != nil {
+			return nil, errUnableToGetUTXOs
+		}
+
+		utxos = append(utxos, utxoPage...)
+
+		// Fetch next page only if there may be more UTXOs
+		if len(utxoPage) < int(b.getUTXOsPageSize) {
+			break
+		}
+	}
+
+	return utxos, nil
+}
+
+// processUTXOs converts raw UTXO bytes to Rosetta coins, skipping
+// duplicates, multisig and non-fungible UTXOs
+func (b *Backend) processUTXOs(ctx context.Context, currencyAssetIDs map[ids.ID]struct{}, utxoBytes [][]byte) ([]*types.Coin, error) {
+	coins := []*types.Coin{}
+
+	// when results are paginated, duplicate UTXOs may be provided. guarantee uniqueness
+	utxoIDs := make(map[string]struct{})
+	for _, bytes := range utxoBytes {
+		utxo := avax.UTXO{}
+		_, err := b.codec.Unmarshal(bytes, &utxo)
+		if err != nil {
+			return nil, errUnableToParseUTXO
+		}
+
+		utxoID := utxo.UTXOID.String()
+		if _, ok := utxoIDs[utxoID]; ok {
+			continue
+		}
+		utxoIDs[utxoID] = struct{}{}
+
+		// Skip UTXO if currencies are specified but they don't contain the UTXOs asset
+		if _, ok := currencyAssetIDs[utxo.AssetID()]; len(currencyAssetIDs) > 0 && !ok {
+			continue
+		}
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok || len(out.Addrs) != 1 {
+			continue
+		}
+
+		currency, err := b.getCurrency(ctx, utxo.AssetID())
+		if err != nil {
+			return nil, err
+		}
+
+		coins = append(coins, &types.Coin{
+			CoinIdentifier: &types.CoinIdentifier{Identifier: utxoID},
+			Amount:         mapper.Amount(new(big.Int).SetUint64(out.Amount()), currency),
+		})
+	}
+
+	return coins, nil
+}
@@ -0,0 +1,162 @@
+package xchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+func TestAccount(t *testing.T) {
+	ctx := context.Background()
+	xChainMock := &mocks.XChainClient{}
+	backend, err := NewBackend(xChainMock, avaxAssetID, xChainNetworkIdentifier)
+	assert.Nil(t, err)
+
+	addr := ids.GenerateTestShortID()
+	otherAddr := ids.GenerateTestShortID()
+	accountAddr, err := address.Format(mapper.XChainNetworkIdentifier, "fuji", addr[:])
+	assert.Nil(t, err)
+
+	tokenAssetID := ids.GenerateTestID()
+	tokenCurrency := &types.Currency{
+		Symbol:   "TKN",
+		Decimals: 2,
+		Metadata: map[string]interface{}{
+			xmapper.MetadataAssetID: tokenAssetID.String(),
+		},
+	}
+
+	avaxUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID(), OutputIndex: 0},
+		Asset:  avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          100,
+			OutputOwners: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+		},
+	}
+	tokenUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID(), OutputIndex: 1},
+		Asset:  avax.Asset{ID: tokenAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          50,
+			OutputOwners: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+		},
+	}
+	multisigUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID(), OutputIndex: 2},
+		Asset:  avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          7,
+			OutputOwners: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr, otherAddr}},
+		},
+	}
+
+	utxoBytes := [][]byte{}
+	for _, utxo := range []*avax.UTXO{avaxUTXO, tokenUTXO, multisigUTXO} {
+		bytes, err := backend.codec.Marshal(txs.CodecVersion, utxo)
+		assert.Nil(t, err)
+		utxoBytes = append(utxoBytes, bytes)
+	}
+
+	lastAccepted := indexer.Container{ID: ids.GenerateTestID()}
+	xChainMock.On("GetLastAccepted", ctx).Return(lastAccepted, nil)
+	xChainMock.On("GetIndex", ctx, lastAccepted.ID).Return(uint64(42), nil)
+	xChainMock.On("GetUTXOs", ctx, []ids.ShortID{addr}, uint32(1024), ids.ShortEmpty, ids.Empty).
+		Return(utxoBytes, addr, tokenUTXO.InputID(), nil)
+	xChainMock.On("GetAssetDescription", ctx, tokenAssetID.String()).Return(&avm.GetAssetDescriptionReply{
+		Symbol:       "TKN",
+		Denomination: 2,
+	}, nil).Once()
+
+	expectedBlockIdentifier := &types.BlockIdentifier{
+		Index: 42,
+		Hash:  lastAccepted.ID.String(),
+	}
+
+	t.Run("Account Coins Test", func(t *testing.T) {
+		resp, err := backend.AccountCoins(ctx, &types.AccountCoinsRequest{
+			NetworkIdentifier: xChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{Address: accountAddr},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, expectedBlockIdentifier, resp.BlockIdentifier)
+		assert.ElementsMatch(t, []*types.Coin{
+			{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: avaxUTXO.UTXOID.String()},
+				Amount:         &types.Amount{Value: "100", Currency: mapper.AtomicAvaxCurrency},
+			},
+			{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: tokenUTXO.UTXOID.String()},
+				Amount:         &types.Amount{Value: "50", Currency: tokenCurrency},
+			},
+		}, resp.Coins)
+	})
+
+	t.Run("Account Coins Test with currency filter", func(t *testing.T) {
+		resp, err := backend.AccountCoins(ctx, &types.AccountCoinsRequest{
+			NetworkIdentifier: xChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{Address: accountAddr},
+			Currencies:        []*types.Currency{tokenCurrency},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Coin{
+			{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: tokenUTXO.UTXOID.String()},
+				Amount:         &types.Amount{Value: "50", Currency: tokenCurrency},
+			},
+		}, resp.Coins)
+	})
+
+	t.Run("Account Balance Test", func(t *testing.T) {
+		resp, err := backend.AccountBalance(ctx, &types.AccountBalanceRequest{
+			NetworkIdentifier: xChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{Address: accountAddr},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, expectedBlockIdentifier, resp.BlockIdentifier)
+		assert.ElementsMatch(t, []*types.Amount{
+			{Value: "100", Currency: mapper.AtomicAvaxCurrency},
+			{Value: "50", Currency: tokenCurrency},
+		}, resp.Balances)
+	})
+
+	t.Run("Account Balance Test fails if a new tx is accepted", func(t *testing.T) {
+		xChainMock := &mocks.XChainClient{}
+		backend, err := NewBackend(xChainMock, avaxAssetID, xChainNetworkIdentifier)
+		assert.Nil(t, err)
+		backend.SetUTXOFetchAttempts(1)
+
+		xChainMock.On("GetLastAccepted", ctx).Return(indexer.Container{ID: ids.GenerateTestID()}, nil).Once()
+		xChainMock.On("GetLastAccepted", ctx).Return(indexer.Container{ID: ids.GenerateTestID()}, nil).Once()
+		xChainMock.On("GetUTXOs", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([][]byte{}, ids.ShortEmpty, ids.Empty, nil)
+
+		resp, terr := backend.AccountBalance(ctx, &types.AccountBalanceRequest{
+			NetworkIdentifier: xChainNetworkIdentifier,
+			AccountIdentifier: &types.AccountIdentifier{Address: accountAddr},
+		})
+
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrChainAdvanced.Code, terr.Code)
+	})
+
+	xChainMock.AssertExpectations(t)
+}
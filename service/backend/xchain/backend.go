@@ -0,0 +1,184 @@
+package xchain
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	"github.com/ava-labs/avalanche-rosetta/service"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/common"
+)
+
+var (
+	_ service.ConstructionBackend = &Backend{}
+	_ service.NetworkBackend      = &Backend{}
+	_ service.AccountBackend      = &Backend{}
+	_ service.BlockBackend        = &Backend{}
+)
+
+type Backend struct {
+	fac               *crypto.FactorySECP256K1R
+	networkIdentifier *types.NetworkIdentifier
+	xClient           client.XChainClient
+	getUTXOsPageSize  uint32
+	utxoFetchAttempts int
+	parser            txs.Parser
+	codec             codec.Manager
+	codecVersion      uint16
+	avaxAssetID       ids.ID
+
+	chainIDsLock sync.Mutex
+	chainIDs     map[string]string
+
+	// currencies caches the currencies of the assets seen so far
+	currenciesLock sync.Mutex
+	currencies     map[ids.ID]*types.Currency
+}
+
+func NewBackend(
+	xClient client.XChainClient,
+	assetID ids.ID,
+	networkIdentifier *types.NetworkIdentifier,
+) (*Backend, error) {
+	parser, err := txs.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+		&nftfx.Fx{},
+		&propertyfx.Fx{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Backend{
+		fac:               &crypto.FactorySECP256K1R{},
+		networkIdentifier: networkIdentifier,
+		xClient:           xClient,
+		getUTXOsPageSize:  1024,
+		utxoFetchAttempts: common.DefaultUTXOFetchAttempts,
+		parser:            parser,
+		codec:             parser.Codec(),
+		codecVersion:      txs.CodecVersion,
+		avaxAssetID:       assetID,
+		currencies:        make(map[ids.ID]*types.Currency),
+	}, nil
+}
+
+// SetUTXOFetchAttempts sets the number of times UTXOs are fetched when new
+// transactions keep being accepted during the fetch
+func (b *Backend) SetUTXOFetchAttempts(attempts int) {
+	b.utxoFetchAttempts = attempts
+}
+
+func (b *Backend) ShouldHandleRequest(req interface{}) bool {
+	switch r := req.(type) {
+	case *types.AccountBalanceRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.AccountCoinsRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.BlockRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.BlockTransactionRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.ConstructionDeriveRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.ConstructionMetadataRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.ConstructionPreprocessRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.ConstructionPayloadsRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.ConstructionParseRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.ConstructionCombineRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.ConstructionHashRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.ConstructionSubmitRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	case *types.NetworkRequest:
+		return xmapper.IsXChain(r.NetworkIdentifier)
+	}
+
+	return false
+}
+
+// getCurrency returns the currency of [assetID], fetching its description from the node on first use
+func (b *Backend) getCurrency(ctx context.Context, assetID ids.ID) (*types.Currency, error) {
+	if assetID == b.avaxAssetID {
+		return xmapper.Currency(b.avaxAssetID, assetID, "", 0), nil
+	}
+
+	b.currenciesLock.Lock()
+	currency, ok := b.currencies[assetID]
+	b.currenciesLock.Unlock()
+	if ok {
+		return currency, nil
+	}
+
+	description, err := b.xClient.GetAssetDescription(ctx, assetID.String())
+	if err != nil {
+		return nil, err
+	}
+	currency = xmapper.Currency(b.avaxAssetID, assetID, description.Symbol, uint8(description.Denomination))
+
+	b.currenciesLock.Lock()
+	b.currencies[assetID] = currency
+	b.currenciesLock.Unlock()
+
+	return currency, nil
+}
+
+// getCurrencies returns the currencies of all fungible assets of [txs]
+func (b *Backend) getCurrencies(ctx context.Context, txs ...*txs.Tx) (map[ids.ID]*types.Currency, error) {
+	currencies := make(map[ids.ID]*types.Currency)
+	for _, tx := range txs {
+		for _, assetID := range xmapper.GetAssetIDs(tx) {
+			if _, ok := currencies[assetID]; ok {
+				continue
+			}
+
+			currency, err := b.getCurrency(ctx, assetID)
+			if err != nil {
+				return nil, err
+			}
+			currencies[assetID] = currency
+		}
+	}
+
+	return currencies, nil
+}
+
+func (b *Backend) getChainIDs(ctx context.Context) (map[string]string, error) {
+	b.chainIDsLock.Lock()
+	defer b.chainIDsLock.Unlock()
+
+	if b.chainIDs == nil {
+		chainIDs := map[string]string{}
+		for _, alias := range []string{
+			mapper.PChainNetworkIdentifier,
+			mapper.CChainNetworkIdentifier,
+			mapper.XChainNetworkIdentifier,
+		} {
+			chainID, err := b.xClient.GetBlockchainID(ctx, alias)
+			if err != nil {
+				return nil, err
+			}
+			chainIDs[chainID.String()] = alias
+		}
+		b.chainIDs = chainIDs
+	}
+
+	return b.chainIDs, nil
+}
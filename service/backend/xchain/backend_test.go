@@ -0,0 +1,83 @@
+package xchain
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+var (
+	xChainNetworkIdentifier = &types.NetworkIdentifier{
+		Blockchain: service.BlockchainName,
+		Network:    mapper.FujiNetwork,
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{
+			Network: mapper.XChainNetworkIdentifier,
+		},
+	}
+
+	avaxAssetID, _ = ids.FromString("U8iRqJoiJm8xZHAacmvYyZVwqQx6uDNtQeP3CQ6fcgQk3JqnK")
+	xChainID, _    = ids.FromString("2JVSBoinj9C2J33VntvzYtVJNZdN2NKiwwKjcumHUWEb5DbBrm")
+	cChainID, _    = ids.FromString("yH8D7ThNJkxmtkuv2jgBa4P1Rn3Qpr4pPr7QYNfcdoS6k6HWp")
+)
+
+func TestShouldHandleRequest(t *testing.T) {
+	pChainNetworkIdentifier := &types.NetworkIdentifier{
+		Blockchain: service.BlockchainName,
+		Network:    mapper.FujiNetwork,
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{
+			Network: mapper.PChainNetworkIdentifier,
+		},
+	}
+
+	cChainNetworkIdentifier := &types.NetworkIdentifier{
+		Blockchain: service.BlockchainName,
+		Network:    mapper.FujiNetwork,
+	}
+
+	backend, err := NewBackend(nil, avaxAssetID, xChainNetworkIdentifier)
+	assert.Nil(t, err)
+
+	testData := []struct {
+		name              string
+		networkIdentifier *types.NetworkIdentifier
+		expected          bool
+	}{
+		{"x-chain", xChainNetworkIdentifier, true},
+		{"p-chain", pChainNetworkIdentifier, false},
+		{"c-chain", cChainNetworkIdentifier, false},
+	}
+
+	for _, tc := range testData {
+		t.Run(fmt.Sprintf("should handle request for %s should return %t", tc.name, tc.expected), func(t *testing.T) {
+			requests := []interface{}{
+				&types.ConstructionDeriveRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.ConstructionPreprocessRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.ConstructionMetadataRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.ConstructionPayloadsRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.ConstructionParseRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.ConstructionCombineRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.ConstructionHashRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.ConstructionSubmitRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.AccountBalanceRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.AccountCoinsRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.BlockRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.BlockTransactionRequest{NetworkIdentifier: tc.networkIdentifier},
+				&types.NetworkRequest{NetworkIdentifier: tc.networkIdentifier},
+			}
+			for _, r := range requests {
+				assert.Equal(t, tc.expected, backend.ShouldHandleRequest(r))
+			}
+		})
+	}
+}
+
+func bigInt(v int64) *big.Int {
+	return big.NewInt(v)
+}
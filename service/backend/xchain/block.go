@@ -0,0 +1,223 @@
+package xchain
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+var (
+	errMissingBlockIndexHash = errors.New("a block index, a block hash or both must be specified")
+	errMismatchedHeight      = errors.New("provided block height does not match height of the block with given hash")
+)
+
+// parsedBlock is a block of the X-chain.
+//
+// The X-chain is a DAG of transactions, so blocks are emulated using the
+// X-chain tx index: the container at index i is the single tx of block i.
+// Transactions created in the X-chain genesis are not indexed, therefore
+// their allocations are not reported by /block.
+type parsedBlock struct {
+	identifier       *types.BlockIdentifier
+	parentIdentifier *types.BlockIdentifier
+	timestamp        int64
+	tx               *txs.Tx
+}
+
+// Block implements the /block endpoint
+func (b *Backend) Block(ctx context.Context, request *types.BlockRequest) (*types.BlockResponse, *types.Error) {
+	block, err := b.getBlock(ctx, request.BlockIdentifier.Index, request.BlockIdentifier.Hash)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+
+	transaction, err := b.parseTransaction(ctx, request.NetworkIdentifier, block.tx)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+
+	return &types.BlockResponse{
+		Block: &types.Block{
+			BlockIdentifier:       block.identifier,
+			ParentBlockIdentifier: block.parentIdentifier,
+			Timestamp:             block.timestamp,
+			Transactions:          []*types.Transaction{transaction},
+		},
+	}, nil
+}
+
+// BlockTransaction implements the /block/transaction endpoint.
+func (b *Backend) BlockTransaction(ctx context.Context, request *types.BlockTransactionRequest) (*types.BlockTransactionResponse, *types.Error) {
+	block, err := b.getBlock(ctx, &request.BlockIdentifier.Index, &request.BlockIdentifier.Hash)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+
+	if block.tx.ID().String() != request.TransactionIdentifier.Hash {
+		return nil, service.ErrTransactionNotFound
+	}
+
+	transaction, err := b.parseTransaction(ctx, request.NetworkIdentifier, block.tx)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+
+	return &types.BlockTransactionResponse{
+		Transaction: transaction,
+	}, nil
+}
+
+func (b *Backend) getBlock(ctx context.Context, index *int64, hash *string) (*parsedBlock, error) {
+	if (index == nil || *index < 0) && (hash == nil || *hash == "") {
+		return nil, errMissingBlockIndexHash
+	}
+
+	if hash == nil || *hash == "" {
+		return b.getBlockAtIndex(ctx, uint64(*index))
+	}
+
+	containerID, err := ids.FromString(*hash)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := b.xClient.GetIndex(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if index != nil && *index >= 0 && uint64(*index) != height {
+		return nil, errMismatchedHeight
+	}
+
+	return b.getBlockAtIndex(ctx, height)
+}
+
+func (b *Backend) getCurrentBlock(ctx context.Context) (*parsedBlock, error) {
+	container, err := b.xClient.GetLastAccepted(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := b.xClient.GetIndex(ctx, container.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.getBlockAtIndex(ctx, height)
+}
+
+func (b *Backend) getBlockAtIndex(ctx context.Context, index uint64) (*parsedBlock, error) {
+	container, err := b.xClient.GetContainerByIndex(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.parser.Parse(container.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	identifier := &types.BlockIdentifier{
+		Index: int64(index),
+		Hash:  container.ID.String(),
+	}
+
+	// Parent block identifier of the first block is set to itself
+	parentIdentifier := identifier
+	if index > 0 {
+		var parent indexer.Container
+		parent, err = b.xClient.GetContainerByIndex(ctx, index-1)
+		if err != nil {
+			return nil, err
+		}
+
+		parentIdentifier = &types.BlockIdentifier{
+			Index: int64(index - 1),
+			Hash:  parent.ID.String(),
+		}
+	}
+
+	return &parsedBlock{
+		identifier:       identifier,
+		parentIdentifier: parentIdentifier,
+		timestamp:        time.Unix(0, container.Timestamp).UnixMilli(),
+		tx:               tx,
+	}, nil
+}
+
+func (b *Backend) parseTransaction(
+	ctx context.Context,
+	networkIdentifier *types.NetworkIdentifier,
+	tx *txs.Tx,
+) (*types.Transaction, error) {
+	dependencyTxs, err := b.fetchDependencyTxs(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	hrp, err := mapper.GetHRP(networkIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	chainIDs, err := b.getChainIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies, err := b.getCurrencies(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	inputAddresses, err := xmapper.GetAccountsFromUTXOs(hrp, dependencyTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := xmapper.NewTxParser(false, hrp, chainIDs, currencies, inputAddresses, dependencyTxs)
+	return parser.Parse(tx)
+}
+
+func (b *Backend) fetchDependencyTxs(ctx context.Context, tx *txs.Tx) (map[string]*txs.Tx, error) {
+	var lock sync.Mutex
+	dependencyTxs := make(map[string]*txs.Tx)
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, txID := range xmapper.GetDependencyTxIDs(tx.Unsigned) {
+		txID := txID
+		eg.Go(func() error {
+			txBytes, err := b.xClient.GetTx(ctx, txID)
+			if err != nil {
+				return err
+			}
+
+			dependencyTx, err := b.parser.Parse(txBytes)
+			if err != nil {
+				return err
+			}
+
+			lock.Lock()
+			dependencyTxs[txID.String()] = dependencyTx
+			lock.Unlock()
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return dependencyTxs, nil
+}
@@ -0,0 +1,116 @@
+package xchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+)
+
+func TestBlock(t *testing.T) {
+	ctx := context.Background()
+	xChainMock := &mocks.XChainClient{}
+	backend, err := NewBackend(xChainMock, avaxAssetID, xChainNetworkIdentifier)
+	assert.Nil(t, err)
+
+	addr := ids.ShortID{1}
+	owners := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}}
+
+	dependencyTx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    5,
+		BlockchainID: xChainID,
+		Outs: []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out:   &secp256k1fx.TransferOutput{Amt: 1_000, OutputOwners: owners},
+		}},
+	}}}
+	assert.Nil(t, backend.parser.InitializeTx(dependencyTx))
+
+	tx := &txs.Tx{Unsigned: &txs.ExportTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    5,
+			BlockchainID: xChainID,
+			Ins: []*avax.TransferableInput{{
+				UTXOID: avax.UTXOID{TxID: dependencyTx.ID(), OutputIndex: 0},
+				Asset:  avax.Asset{ID: avaxAssetID},
+				In: &secp256k1fx.TransferInput{
+					Amt:   1_000,
+					Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+				},
+			}},
+			Outs: []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out:   &secp256k1fx.TransferOutput{Amt: 400, OutputOwners: owners},
+			}},
+		}},
+		DestinationChain: cChainID,
+		ExportedOuts: []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out:   &secp256k1fx.TransferOutput{Amt: 500, OutputOwners: owners},
+		}},
+	}}
+	assert.Nil(t, backend.parser.InitializeTx(tx))
+
+	parentContainer := indexer.Container{ID: dependencyTx.ID(), Bytes: dependencyTx.Bytes(), Timestamp: 1_000_000_000}
+	container := indexer.Container{ID: tx.ID(), Bytes: tx.Bytes(), Timestamp: 2_000_000_000}
+
+	xChainMock.On("GetIndex", ctx, tx.ID()).Return(uint64(8), nil)
+	xChainMock.On("GetContainerByIndex", ctx, uint64(8)).Return(container, nil)
+	xChainMock.On("GetContainerByIndex", ctx, uint64(7)).Return(parentContainer, nil)
+	xChainMock.On("GetTx", mock.Anything, dependencyTx.ID()).Return(dependencyTx.Bytes(), nil)
+	xChainMock.On("GetBlockchainID", ctx, mapper.PChainNetworkIdentifier).Return(ids.Empty, nil)
+	xChainMock.On("GetBlockchainID", ctx, mapper.CChainNetworkIdentifier).Return(cChainID, nil)
+	xChainMock.On("GetBlockchainID", ctx, mapper.XChainNetworkIdentifier).Return(xChainID, nil)
+
+	hash := tx.ID().String()
+	resp, terr := backend.Block(ctx, &types.BlockRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		BlockIdentifier:   &types.PartialBlockIdentifier{Hash: &hash},
+	})
+	assert.Nil(t, terr)
+
+	block := resp.Block
+	assert.Equal(t, &types.BlockIdentifier{Index: 8, Hash: hash}, block.BlockIdentifier)
+	assert.Equal(t, &types.BlockIdentifier{Index: 7, Hash: dependencyTx.ID().String()}, block.ParentBlockIdentifier)
+	assert.Equal(t, int64(2_000), block.Timestamp)
+	assert.Equal(t, 1, len(block.Transactions))
+
+	transaction := block.Transactions[0]
+	assert.Equal(t, hash, transaction.TransactionIdentifier.Hash)
+	assert.Equal(t, xmapper.OpExportAvax, transaction.Metadata[pmapper.MetadataTxType])
+	assert.Equal(t, 2, len(transaction.Operations))
+	assert.Equal(t, "-1000", transaction.Operations[0].Amount.Value)
+	assert.Equal(t, "400", transaction.Operations[1].Amount.Value)
+	assert.Equal(t, tx.ID().String()+":0", transaction.Operations[1].CoinChange.CoinIdentifier.Identifier)
+
+	exportedOutputs, ok := transaction.Metadata[mapper.MetadataExportedOutputs].([]*types.Operation)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(exportedOutputs))
+	assert.Equal(t, "500", exportedOutputs[0].Amount.Value)
+	assert.Equal(t, tx.ID().String()+":1", exportedOutputs[0].CoinChange.CoinIdentifier.Identifier)
+	assert.Equal(t, "C-fuji1qyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnzwt2u", exportedOutputs[0].Account.Address)
+
+	t.Run("block transaction", func(t *testing.T) {
+		resp, terr := backend.BlockTransaction(ctx, &types.BlockTransactionRequest{
+			NetworkIdentifier:     xChainNetworkIdentifier,
+			BlockIdentifier:       &types.BlockIdentifier{Index: 8, Hash: hash},
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: hash},
+		})
+		assert.Nil(t, terr)
+		assert.Equal(t, transaction, resp.Transaction)
+	})
+
+	xChainMock.AssertExpectations(t)
+}
@@ -0,0 +1,262 @@
+package xchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	"github.com/ava-labs/avalanche-rosetta/service"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/common"
+)
+
+var (
+	errUnknownTxType = errors.New("unknown tx type")
+	errUndecodableTx = errors.New("undecodable transaction")
+	errNoTxGiven     = errors.New("no transaction was given")
+)
+
+func (b *Backend) ConstructionDerive(ctx context.Context, req *types.ConstructionDeriveRequest) (*types.ConstructionDeriveResponse, *types.Error) {
+	return common.DeriveBech32Address(b.fac, mapper.XChainNetworkIdentifier, req)
+}
+
+func (b *Backend) ConstructionPreprocess(
+	ctx context.Context,
+	req *types.ConstructionPreprocessRequest,
+) (*types.ConstructionPreprocessResponse, *types.Error) {
+	matches, err := common.MatchOperations(req.Operations)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	reqMetadata := req.Metadata
+	if reqMetadata == nil {
+		reqMetadata = make(map[string]interface{})
+	}
+	reqMetadata[pmapper.MetadataOpType] = matches[0].Operations[0].Type
+
+	return &types.ConstructionPreprocessResponse{
+		Options: reqMetadata,
+	}, nil
+}
+
+func (b *Backend) ConstructionMetadata(
+	ctx context.Context,
+	req *types.ConstructionMetadataRequest,
+) (*types.ConstructionMetadataResponse, *types.Error) {
+	opMetadata, err := pmapper.ParseOpMetadata(req.Options)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	var preprocessOptions pmapper.ImportExportOptions
+	if err := mapper.UnmarshalJSONMap(req.Options, &preprocessOptions); err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	metadata := &pmapper.Metadata{}
+	switch opMetadata.Type {
+	case xmapper.OpBase:
+	case xmapper.OpImportAvax:
+		sourceChainID, err := b.xClient.GetBlockchainID(ctx, preprocessOptions.SourceChain)
+		if err != nil {
+			return nil, service.WrapError(service.ErrClientError, err)
+		}
+		metadata.ImportMetadata = &pmapper.ImportMetadata{
+			SourceChainID: sourceChainID,
+		}
+	case xmapper.OpExportAvax:
+		destinationChainID, err := b.xClient.GetBlockchainID(ctx, preprocessOptions.DestinationChain)
+		if err != nil {
+			return nil, service.WrapError(service.ErrClientError, err)
+		}
+		metadata.ExportMetadata = &pmapper.ExportMetadata{
+			DestinationChain:   preprocessOptions.DestinationChain,
+			DestinationChainID: destinationChainID,
+		}
+	default:
+		return nil, service.WrapError(
+			service.ErrInternalError,
+			fmt.Errorf("invalid tx type for building metadata: %s", opMetadata.Type),
+		)
+	}
+
+	networkID, err := b.xClient.GetNetworkID(ctx)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+	metadata.NetworkID = networkID
+
+	xChainID, err := b.xClient.GetBlockchainID(ctx, mapper.XChainNetworkIdentifier)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+	metadata.BlockchainID = xChainID
+
+	fees, err := b.xClient.GetTxFee(ctx)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+	suggestedFee := mapper.AtomicAvaxAmount(new(big.Int).SetUint64(uint64(fees.TxFee)))
+
+	metadataMap, err := mapper.MarshalJSONMap(metadata)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInternalError, err)
+	}
+
+	return &types.ConstructionMetadataResponse{
+		Metadata:     metadataMap,
+		SuggestedFee: []*types.Amount{suggestedFee},
+	}, nil
+}
+
+func (b *Backend) ConstructionPayloads(ctx context.Context, req *types.ConstructionPayloadsRequest) (*types.ConstructionPayloadsResponse, *types.Error) {
+	builder := xTxBuilder{
+		avaxAssetID:  b.avaxAssetID,
+		codec:        b.codec,
+		codecVersion: b.codecVersion,
+	}
+	return common.BuildPayloads(builder, req)
+}
+
+func (b *Backend) ConstructionParse(ctx context.Context, req *types.ConstructionParseRequest) (*types.ConstructionParseResponse, *types.Error) {
+	rosettaTx, err := b.parsePayloadTxFromString(req.Transaction)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	hrp, err := mapper.GetHRP(req.NetworkIdentifier)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, "incorrect network identifier")
+	}
+
+	chainIDs := map[string]string{}
+	if rosettaTx.DestinationChainID != nil {
+		chainIDs[rosettaTx.DestinationChainID.String()] = rosettaTx.DestinationChain
+	}
+
+	txParser := xTxParser{
+		ctx:      ctx,
+		backend:  b,
+		hrp:      hrp,
+		chainIDs: chainIDs,
+	}
+
+	return common.Parse(txParser, rosettaTx, req.Signed)
+}
+
+func (b *Backend) ConstructionCombine(ctx context.Context, req *types.ConstructionCombineRequest) (*types.ConstructionCombineResponse, *types.Error) {
+	rosettaTx, err := b.parsePayloadTxFromString(req.UnsignedTransaction)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	return common.Combine(b, rosettaTx, req.Signatures)
+}
+
+func (b *Backend) CombineTx(tx common.AvaxTx, signatures []*types.Signature) (common.AvaxTx, *types.Error) {
+	xTx, ok := tx.(*xTx)
+	if !ok {
+		return nil, service.WrapError(service.ErrInvalidInput, "invalid transaction")
+	}
+
+	ins, err := getTxInputs(xTx.Tx.Unsigned)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	creds, err := common.BuildCredentialList(ins, signatures)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	xTx.Tx.Creds = make([]*fxs.FxCredential, len(creds))
+	for i, cred := range creds {
+		xTx.Tx.Creds[i] = &fxs.FxCredential{Verifiable: cred}
+	}
+
+	err = b.parser.InitializeTx(xTx.Tx)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	return xTx, nil
+}
+
+// getTxInputs fetches tx inputs in the order of their credentials based on the tx type.
+func getTxInputs(
+	unsignedTx txs.UnsignedTx,
+) ([]*avax.TransferableInput, error) {
+	switch utx := unsignedTx.(type) {
+	case *txs.BaseTx:
+		return utx.Ins, nil
+	case *txs.ImportTx:
+		ins := make([]*avax.TransferableInput, 0, len(utx.Ins)+len(utx.ImportedIns))
+		ins = append(ins, utx.Ins...)
+		return append(ins, utx.ImportedIns...), nil
+	case *txs.ExportTx:
+		return utx.Ins, nil
+	default:
+		return nil, errUnknownTxType
+	}
+}
+
+func (b *Backend) ConstructionHash(
+	ctx context.Context,
+	req *types.ConstructionHashRequest,
+) (*types.TransactionIdentifierResponse, *types.Error) {
+	rosettaTx, err := b.parsePayloadTxFromString(req.SignedTransaction)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	return common.HashTx(rosettaTx)
+}
+
+func (b *Backend) ConstructionSubmit(
+	ctx context.Context,
+	req *types.ConstructionSubmitRequest,
+) (*types.TransactionIdentifierResponse, *types.Error) {
+	rosettaTx, err := b.parsePayloadTxFromString(req.SignedTransaction)
+	if err != nil {
+		return nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	return common.SubmitTx(ctx, b, rosettaTx)
+}
+
+// Defining IssueTx here without rpc.Options... to be able to use it with common.SubmitTx
+func (b *Backend) IssueTx(ctx context.Context, txByte []byte) (ids.ID, error) {
+	return b.xClient.IssueTx(ctx, txByte)
+}
+
+func (b *Backend) parsePayloadTxFromString(transaction string) (*common.RosettaTx, error) {
+	// Unmarshal input transaction
+	payloadsTx := &common.RosettaTx{
+		Tx: &xTx{
+			Codec:        b.codec,
+			CodecVersion: b.codecVersion,
+		},
+	}
+
+	err := json.Unmarshal([]byte(transaction), payloadsTx)
+	if err != nil {
+		return nil, errUndecodableTx
+	}
+
+	if payloadsTx.Tx == nil {
+		return nil, errNoTxGiven
+	}
+
+	return payloadsTx, nil
+}
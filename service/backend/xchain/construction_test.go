@@ -0,0 +1,256 @@
+package xchain
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/common"
+)
+
+var (
+	xAccount1 = &types.AccountIdentifier{Address: "X-fuji1hfc0psu8lp8vn0v7fq7t4qnkwjwwh4l7fna9dx"}
+	xAccount2 = &types.AccountIdentifier{Address: "X-fuji123zu6qwhtd9qdd45ryu3j0qtr325gjgddys6u8"}
+	xAccount3 = &types.AccountIdentifier{Address: "X-fuji1ea7dxk8zazpyf8tgc8yg3xyfatey0deqvg9pv2"}
+)
+
+func TestConstructionDerive(t *testing.T) {
+	backend, err := NewBackend(&mocks.XChainClient{}, avaxAssetID, xChainNetworkIdentifier)
+	assert.Nil(t, err)
+
+	publicKey, err := hex.DecodeString("02e0d4392cfa224d4be19db416b3cf62e90fb2b7015e7b62a95c8cb490514943f6")
+	assert.Nil(t, err)
+
+	resp, terr := backend.ConstructionDerive(context.Background(), &types.ConstructionDeriveRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		PublicKey: &types.PublicKey{
+			Bytes:     publicKey,
+			CurveType: types.Secp256k1,
+		},
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, "X-fuji15f9g0h5xkr5cp47n6u3qxj6yjtzzzrdr23a3tl", resp.AccountIdentifier.Address)
+}
+
+func TestBaseTxConstruction(t *testing.T) {
+	ctx := context.Background()
+	xChainMock := &mocks.XChainClient{}
+	backend, err := NewBackend(xChainMock, avaxAssetID, xChainNetworkIdentifier)
+	assert.Nil(t, err)
+
+	// The first input spends a UTXO sorting after the one of the second input
+	coin1 := avax.UTXOID{TxID: ids.ID{2}, OutputIndex: 0}
+	coin2 := avax.UTXOID{TxID: ids.ID{1}, OutputIndex: 3}
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                xmapper.OpBase,
+			Account:             xAccount1,
+			Amount:              mapper.AtomicAvaxAmount(bigInt(-600_000_000)),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: coin1.String()},
+				CoinAction:     types.CoinSpent,
+			},
+			Metadata: map[string]interface{}{"type": pmapper.OpTypeInput, "sig_indices": []interface{}{0.0}, "locktime": 0.0},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                xmapper.OpBase,
+			Account:             xAccount2,
+			Amount:              mapper.AtomicAvaxAmount(bigInt(-500_000_000)),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: coin2.String()},
+				CoinAction:     types.CoinSpent,
+			},
+			Metadata: map[string]interface{}{"type": pmapper.OpTypeInput, "sig_indices": []interface{}{0.0}, "locktime": 0.0},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 2},
+			Type:                xmapper.OpBase,
+			Account:             xAccount3,
+			Amount:              mapper.AtomicAvaxAmount(bigInt(1_099_000_000)),
+			Metadata:            map[string]interface{}{"type": pmapper.OpTypeOutput, "threshold": 1.0, "locktime": 0.0},
+		},
+	}
+
+	preprocessResp, terr := backend.ConstructionPreprocess(ctx, &types.ConstructionPreprocessRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		Operations:        operations,
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, xmapper.OpBase, preprocessResp.Options[pmapper.MetadataOpType])
+
+	xChainMock.On("GetNetworkID", ctx).Return(uint32(5), nil)
+	xChainMock.On("GetBlockchainID", ctx, mapper.XChainNetworkIdentifier).Return(xChainID, nil)
+	xChainMock.On("GetTxFee", ctx).Return(&info.GetTxFeeResponse{TxFee: 1_000_000}, nil)
+
+	metadataResp, terr := backend.ConstructionMetadata(ctx, &types.ConstructionMetadataRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		Options:           preprocessResp.Options,
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, []*types.Amount{mapper.AtomicAvaxAmount(bigInt(1_000_000))}, metadataResp.SuggestedFee)
+
+	payloadsResp, terr := backend.ConstructionPayloads(ctx, &types.ConstructionPayloadsRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		Operations:        operations,
+		Metadata:          metadataResp.Metadata,
+	})
+	assert.Nil(t, terr)
+
+	// Signers follow the order of the sorted inputs
+	assert.Equal(t, 2, len(payloadsResp.Payloads))
+	assert.Equal(t, xAccount2, payloadsResp.Payloads[0].AccountIdentifier)
+	assert.Equal(t, xAccount1, payloadsResp.Payloads[1].AccountIdentifier)
+
+	parseResp, terr := backend.ConstructionParse(ctx, &types.ConstructionParseRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		Transaction:       payloadsResp.UnsignedTransaction,
+		Signed:            false,
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, 3, len(parseResp.Operations))
+	assert.Equal(t, coin2.String(), parseResp.Operations[0].CoinChange.CoinIdentifier.Identifier)
+	assert.Equal(t, xAccount2, parseResp.Operations[0].Account)
+	assert.Equal(t, coin1.String(), parseResp.Operations[1].CoinChange.CoinIdentifier.Identifier)
+	assert.Equal(t, xAccount1, parseResp.Operations[1].Account)
+	assert.Equal(t, operations[2].Account, parseResp.Operations[2].Account)
+	assert.Equal(t, operations[2].Amount, parseResp.Operations[2].Amount)
+
+	signatures := []*types.Signature{
+		{SigningPayload: payloadsResp.Payloads[0], Bytes: make([]byte, 65)},
+		{SigningPayload: payloadsResp.Payloads[1], Bytes: make([]byte, 65)},
+	}
+	combineResp, terr := backend.ConstructionCombine(ctx, &types.ConstructionCombineRequest{
+		NetworkIdentifier:   xChainNetworkIdentifier,
+		UnsignedTransaction: payloadsResp.UnsignedTransaction,
+		Signatures:          signatures,
+	})
+	assert.Nil(t, terr)
+
+	signedTx, err := backend.parsePayloadTxFromString(combineResp.SignedTransaction)
+	assert.Nil(t, err)
+	baseTx, ok := signedTx.Tx.(*xTx).Tx.Unsigned.(*txs.BaseTx)
+	assert.True(t, ok)
+	assert.Equal(t, xChainID, baseTx.BlockchainID)
+	assert.Equal(t, 2, len(signedTx.Tx.(*xTx).Tx.Creds))
+
+	signedParseResp, terr := backend.ConstructionParse(ctx, &types.ConstructionParseRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		Transaction:       combineResp.SignedTransaction,
+		Signed:            true,
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, []*types.AccountIdentifier{xAccount2, xAccount1}, signedParseResp.AccountIdentifierSigners)
+
+	hashResp, terr := backend.ConstructionHash(ctx, &types.ConstructionHashRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		SignedTransaction: combineResp.SignedTransaction,
+	})
+	assert.Nil(t, terr)
+
+	signedBytes, err := signedTx.Tx.Marshal()
+	assert.Nil(t, err)
+	parsedTx, err := backend.parser.Parse(signedBytes)
+	assert.Nil(t, err)
+	assert.Equal(t, parsedTx.ID().String(), hashResp.TransactionIdentifier.Hash)
+
+	xChainMock.On("IssueTx", ctx, signedBytes).Return(parsedTx.ID(), nil)
+	submitResp, terr := backend.ConstructionSubmit(ctx, &types.ConstructionSubmitRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		SignedTransaction: combineResp.SignedTransaction,
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, hashResp.TransactionIdentifier, submitResp.TransactionIdentifier)
+
+	xChainMock.AssertExpectations(t)
+}
+
+func TestExportTxConstruction(t *testing.T) {
+	ctx := context.Background()
+	xChainMock := &mocks.XChainClient{}
+	backend, err := NewBackend(xChainMock, avaxAssetID, xChainNetworkIdentifier)
+	assert.Nil(t, err)
+
+	coin := avax.UTXOID{TxID: ids.ID{1}, OutputIndex: 0}
+	cAccount := &types.AccountIdentifier{Address: "C-fuji123zu6qwhtd9qdd45ryu3j0qtr325gjgddys6u8"}
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                xmapper.OpExportAvax,
+			Account:             xAccount1,
+			Amount:              mapper.AtomicAvaxAmount(bigInt(-1_000_000_000)),
+			CoinChange: &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: coin.String()},
+				CoinAction:     types.CoinSpent,
+			},
+			Metadata: map[string]interface{}{"type": pmapper.OpTypeInput, "sig_indices": []interface{}{0.0}, "locktime": 0.0},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                xmapper.OpExportAvax,
+			Account:             cAccount,
+			Amount:              mapper.AtomicAvaxAmount(bigInt(999_000_000)),
+			Metadata:            map[string]interface{}{"type": pmapper.OpTypeExport, "threshold": 1.0, "locktime": 0.0},
+		},
+	}
+
+	preprocessResp, terr := backend.ConstructionPreprocess(ctx, &types.ConstructionPreprocessRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		Operations:        operations,
+		Metadata:          map[string]interface{}{"destination_chain": mapper.CChainNetworkIdentifier},
+	})
+	assert.Nil(t, terr)
+
+	xChainMock.On("GetNetworkID", ctx).Return(uint32(5), nil)
+	xChainMock.On("GetBlockchainID", ctx, mapper.XChainNetworkIdentifier).Return(xChainID, nil)
+	xChainMock.On("GetBlockchainID", ctx, mapper.CChainNetworkIdentifier).Return(cChainID, nil)
+	xChainMock.On("GetTxFee", ctx).Return(&info.GetTxFeeResponse{TxFee: 1_000_000}, nil)
+
+	metadataResp, terr := backend.ConstructionMetadata(ctx, &types.ConstructionMetadataRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		Options:           preprocessResp.Options,
+	})
+	assert.Nil(t, terr)
+
+	payloadsResp, terr := backend.ConstructionPayloads(ctx, &types.ConstructionPayloadsRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		Operations:        operations,
+		Metadata:          metadataResp.Metadata,
+	})
+	assert.Nil(t, terr)
+
+	var rosettaTx common.RosettaTx
+	rosettaTx.Tx = &xTx{Codec: backend.codec, CodecVersion: backend.codecVersion}
+	assert.Nil(t, json.Unmarshal([]byte(payloadsResp.UnsignedTransaction), &rosettaTx))
+	exportTx, ok := rosettaTx.Tx.(*xTx).Tx.Unsigned.(*txs.ExportTx)
+	assert.True(t, ok)
+	assert.Equal(t, cChainID, exportTx.DestinationChain)
+	assert.Equal(t, 1, len(exportTx.ExportedOuts))
+
+	parseResp, terr := backend.ConstructionParse(ctx, &types.ConstructionParseRequest{
+		NetworkIdentifier: xChainNetworkIdentifier,
+		Transaction:       payloadsResp.UnsignedTransaction,
+		Signed:            false,
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, 2, len(parseResp.Operations))
+	assert.Equal(t, cAccount, parseResp.Operations[1].Account)
+	assert.Equal(t, operations[1].Amount, parseResp.Operations[1].Amount)
+
+	xChainMock.AssertExpectations(t)
+}
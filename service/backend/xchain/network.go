@@ -0,0 +1,75 @@
+package xchain
+
+import (
+	"context"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	"github.com/ava-labs/avalanche-rosetta/service"
+)
+
+func (b *Backend) NetworkIdentifier() *types.NetworkIdentifier {
+	return b.networkIdentifier
+}
+
+func (b *Backend) NetworkStatus(ctx context.Context, req *types.NetworkRequest) (*types.NetworkStatusResponse, *types.Error) {
+	// Fetch peers
+	infoPeers, err := b.xClient.Peers(ctx)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+	peers := mapper.Peers(infoPeers)
+
+	// Check if network is bootstrapped
+	ready, err := b.xClient.IsBootstrapped(ctx, mapper.XChainNetworkIdentifier)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+
+	genesisBlock, err := b.getBlockAtIndex(ctx, 0)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+
+	if !ready {
+		return &types.NetworkStatusResponse{
+			CurrentBlockIdentifier: genesisBlock.identifier,
+			CurrentBlockTimestamp:  genesisBlock.timestamp,
+			GenesisBlockIdentifier: genesisBlock.identifier,
+			SyncStatus:             mapper.StageBootstrap,
+			Peers:                  peers,
+		}, nil
+	}
+
+	currentBlock, err := b.getCurrentBlock(ctx)
+	if err != nil {
+		return nil, service.WrapError(service.ErrClientError, err)
+	}
+
+	return &types.NetworkStatusResponse{
+		CurrentBlockIdentifier: currentBlock.identifier,
+		CurrentBlockTimestamp:  currentBlock.timestamp,
+		GenesisBlockIdentifier: genesisBlock.identifier,
+		SyncStatus:             mapper.StageSynced,
+		Peers:                  peers,
+	}, nil
+}
+
+func (b *Backend) NetworkOptions(ctx context.Context, request *types.NetworkRequest) (*types.NetworkOptionsResponse, *types.Error) {
+	return &types.NetworkOptionsResponse{
+		Version: &types.Version{
+			RosettaVersion:    types.RosettaAPIVersion,
+			NodeVersion:       service.NodeVersion,
+			MiddlewareVersion: types.String(service.MiddlewareVersion),
+		},
+		Allow: &types.Allow{
+			OperationStatuses:       mapper.OperationStatuses,
+			OperationTypes:          xmapper.OperationTypes,
+			CallMethods:             xmapper.CallMethods,
+			Errors:                  service.Errors,
+			HistoricalBalanceLookup: false,
+		},
+	}, nil
+}
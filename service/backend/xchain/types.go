@@ -0,0 +1,118 @@
+package xchain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	pmapper "github.com/ava-labs/avalanche-rosetta/mapper/pchain"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
+	"github.com/ava-labs/avalanche-rosetta/service"
+	"github.com/ava-labs/avalanche-rosetta/service/backend/common"
+)
+
+var errInvalidTransaction = errors.New("invalid transaction")
+
+type xTx struct {
+	Tx           *txs.Tx
+	Codec        codec.Manager
+	CodecVersion uint16
+}
+
+func (x *xTx) Marshal() ([]byte, error) {
+	return x.Codec.Marshal(x.CodecVersion, x.Tx)
+}
+
+func (x *xTx) Unmarshal(bytes []byte) error {
+	tx := txs.Tx{}
+	_, err := x.Codec.Unmarshal(bytes, &tx)
+	if err != nil {
+		return err
+	}
+	x.Tx = &tx
+	return nil
+}
+
+func (x *xTx) SigningPayload() ([]byte, error) {
+	unsignedBytes, err := x.Codec.Marshal(x.CodecVersion, &x.Tx.Unsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := hashing.ComputeHash256(unsignedBytes)
+	return hash, nil
+}
+
+func (x *xTx) Hash() ([]byte, error) {
+	bytes, err := x.Codec.Marshal(x.CodecVersion, &x.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := hashing.ComputeHash256(bytes)
+	return hash, nil
+}
+
+type xTxBuilder struct {
+	avaxAssetID  ids.ID
+	codec        codec.Manager
+	codecVersion uint16
+}
+
+func (x xTxBuilder) BuildTx(operations []*types.Operation, metadataMap map[string]interface{}) (common.AvaxTx, []*types.AccountIdentifier, *types.Error) {
+	var metadata pmapper.Metadata
+	err := mapper.UnmarshalJSONMap(metadataMap, &metadata)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	matches, err := common.MatchOperations(operations)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	opType := matches[0].Operations[0].Type
+	tx, signers, err := xmapper.BuildTx(opType, matches, metadata, x.codec, x.avaxAssetID)
+	if err != nil {
+		return nil, nil, service.WrapError(service.ErrInvalidInput, err)
+	}
+
+	return &xTx{
+		Tx:           tx,
+		Codec:        x.codec,
+		CodecVersion: x.codecVersion,
+	}, signers, nil
+}
+
+type xTxParser struct {
+	ctx      context.Context
+	backend  *Backend
+	hrp      string
+	chainIDs map[string]string
+}
+
+func (x xTxParser) ParseTx(tx *common.RosettaTx, inputAddresses map[string]*types.AccountIdentifier) ([]*types.Operation, error) {
+	xTx, ok := tx.Tx.(*xTx)
+	if !ok {
+		return nil, errInvalidTransaction
+	}
+
+	currencies, err := x.backend.getCurrencies(x.ctx, xTx.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := xmapper.NewTxParser(true, x.hrp, x.chainIDs, currencies, inputAddresses, nil)
+	transaction, err := parser.Parse(xTx.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction.Operations, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"sync/atomic"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// DefaultBlockCacheSize is the default number of blocks kept by a BlockCache
+const DefaultBlockCacheSize = 256
+
+// BlockCache is a size bounded cache of mapped blocks, keyed by hash and height.
+// Blocks are final once accepted, so only accepted blocks must be put in the cache.
+//
+// A nil BlockCache is valid and caches nothing.
+type BlockCache struct {
+	blocks  *cache.LRU // block hash -> *types.Block
+	heights *cache.LRU // block height -> block hash
+
+	hits   uint64
+	misses uint64
+}
+
+// NewBlockCache returns a cache of [size] blocks, or nil if [size] is not positive
+func NewBlockCache(size int) *BlockCache {
+	if size <= 0 {
+		return nil
+	}
+
+	return &BlockCache{
+		blocks:  &cache.LRU{Size: size},
+		heights: &cache.LRU{Size: size},
+	}
+}
+
+// Get returns the cached block matching [identifier]
+func (c *BlockCache) Get(identifier *types.PartialBlockIdentifier) (*types.Block, bool) {
+	if c == nil || identifier == nil {
+		return nil, false
+	}
+
+	block, ok := c.get(identifier)
+	if ok {
+		atomic.AddUint64(&c.hits, 1)
+	} else {
+		atomic.AddUint64(&c.misses, 1)
+	}
+	return block, ok
+}
+
+func (c *BlockCache) get(identifier *types.PartialBlockIdentifier) (*types.Block, bool) {
+	var hash string
+	switch {
+	case identifier.Hash != nil:
+		hash = *identifier.Hash
+	case identifier.Index != nil:
+		cachedHash, ok := c.heights.Get(*identifier.Index)
+		if !ok {
+			return nil, false
+		}
+		hash = cachedHash.(string)
+	default:
+		return nil, false
+	}
+
+	cached, ok := c.blocks.Get(hash)
+	if !ok {
+		return nil, false
+	}
+
+	block := cached.(*types.Block)
+	if identifier.Index != nil && *identifier.Index != block.BlockIdentifier.Index {
+		return nil, false
+	}
+	return block, true
+}
+
+// GetTransaction returns the transaction [hash] of the cached block matching [identifier]
+func (c *BlockCache) GetTransaction(identifier *types.BlockIdentifier, hash string) (*types.Transaction, bool) {
+	block, ok := c.Get(&types.PartialBlockIdentifier{
+		Index: &identifier.Index,
+		Hash:  &identifier.Hash,
+	})
+	if !ok {
+		return nil, false
+	}
+
+	for _, tx := range block.Transactions {
+		if tx.TransactionIdentifier.Hash == hash {
+			return tx, true
+		}
+	}
+	return nil, false
+}
+
+// Put caches [block], which must be accepted
+func (c *BlockCache) Put(block *types.Block) {
+	if c == nil {
+		return
+	}
+
+	c.blocks.Put(block.BlockIdentifier.Hash, block)
+	c.heights.Put(block.BlockIdentifier.Index, block.BlockIdentifier.Hash)
+}
+
+// BlockCacheStats returns the number of block cache hits and misses
+func (c *BlockCache) BlockCacheStats() (hits uint64, misses uint64) {
+	if c == nil {
+		return 0, 0
+	}
+	return atomic.LoadUint64(&c.hits), atomic.LoadUint64(&c.misses)
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockCache(t *testing.T) {
+	makeBlock := func(index int64, hash string) *types.Block {
+		return &types.Block{
+			BlockIdentifier: &types.BlockIdentifier{Index: index, Hash: hash},
+			Transactions: []*types.Transaction{
+				{TransactionIdentifier: &types.TransactionIdentifier{Hash: hash + "-tx"}},
+			},
+		}
+	}
+
+	t.Run("nil cache", func(t *testing.T) {
+		c := NewBlockCache(0)
+		assert.Nil(t, c)
+
+		c.Put(makeBlock(1, "0x1"))
+		block, ok := c.Get(&types.PartialBlockIdentifier{Index: types.Int64(1)})
+		assert.False(t, ok)
+		assert.Nil(t, block)
+	})
+
+	t.Run("get by hash and height", func(t *testing.T) {
+		c := NewBlockCache(2)
+		block := makeBlock(1, "0x1")
+		c.Put(block)
+
+		cached, ok := c.Get(&types.PartialBlockIdentifier{Hash: types.String("0x1")})
+		assert.True(t, ok)
+		assert.Equal(t, block, cached)
+
+		cached, ok = c.Get(&types.PartialBlockIdentifier{Index: types.Int64(1)})
+		assert.True(t, ok)
+		assert.Equal(t, block, cached)
+
+		cached, ok = c.Get(&types.PartialBlockIdentifier{Index: types.Int64(1), Hash: types.String("0x1")})
+		assert.True(t, ok)
+		assert.Equal(t, block, cached)
+
+		_, ok = c.Get(&types.PartialBlockIdentifier{Index: types.Int64(2), Hash: types.String("0x1")})
+		assert.False(t, ok)
+
+		_, ok = c.Get(&types.PartialBlockIdentifier{Index: types.Int64(2)})
+		assert.False(t, ok)
+
+		hits, misses := c.BlockCacheStats()
+		assert.Equal(t, uint64(3), hits)
+		assert.Equal(t, uint64(2), misses)
+	})
+
+	t.Run("get transaction", func(t *testing.T) {
+		c := NewBlockCache(2)
+		c.Put(makeBlock(1, "0x1"))
+
+		tx, ok := c.GetTransaction(&types.BlockIdentifier{Index: 1, Hash: "0x1"}, "0x1-tx")
+		assert.True(t, ok)
+		assert.Equal(t, "0x1-tx", tx.TransactionIdentifier.Hash)
+
+		_, ok = c.GetTransaction(&types.BlockIdentifier{Index: 1, Hash: "0x1"}, "0x2-tx")
+		assert.False(t, ok)
+	})
+
+	t.Run("evicts least recently used blocks", func(t *testing.T) {
+		c := NewBlockCache(2)
+		c.Put(makeBlock(1, "0x1"))
+		c.Put(makeBlock(2, "0x2"))
+		c.Put(makeBlock(3, "0x3"))
+
+		_, ok := c.Get(&types.PartialBlockIdentifier{Index: types.Int64(1)})
+		assert.False(t, ok)
+		_, ok = c.Get(&types.PartialBlockIdentifier{Hash: types.String("0x3")})
+		assert.True(t, ok)
+	})
+}
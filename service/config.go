@@ -5,6 +5,8 @@ import (
 
 	ethtypes "github.com/ava-labs/coreth/core/types"
 	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
 )
 
 // Config holds the service configuration
@@ -18,11 +20,27 @@ type Config struct {
 	TokenWhiteList     []string
 	IndexUnknownTokens bool
 
+	// WrappedNativeTokens lists the contracts whose Deposit and Withdrawal
+	// events mint and burn tokens, such as WAVAX
+	WrappedNativeTokens []string
+
+	// IncludeZeroValueCalls reports zero value internal calls as operations
+	IncludeZeroValueCalls bool
+
+	// MetadataVerbosity controls the transaction metadata of /block responses
+	MetadataVerbosity string
+
+	// ReceiptFetchConcurrency bounds the concurrent receipt requests of a
+	// block when the node rejects batched requests
+	ReceiptFetchConcurrency int
+
 	// Upgrade Times
 	AP5Activation uint64
 }
 
 const (
+	DefaultReceiptFetchConcurrency = 16
+
 	ModeOffline        = "offline"
 	ModeOnline         = "online"
 	StandardIngestion  = "standard"
@@ -58,3 +76,22 @@ func (c Config) IsTokenListEmpty() bool {
 func (c Config) Signer() ethtypes.Signer {
 	return ethtypes.LatestSignerForChainID(c.ChainID)
 }
+
+func (c Config) receiptFetchConcurrency() int {
+	if c.ReceiptFetchConcurrency > 0 {
+		return c.ReceiptFetchConcurrency
+	}
+	return DefaultReceiptFetchConcurrency
+}
+
+// transactionOptions returns the options of the C-chain transaction mapping
+func (c Config) transactionOptions() mapper.TransactionOptions {
+	return mapper.TransactionOptions{
+		AnalyticsMode:         c.IsAnalyticsMode(),
+		TokenWhiteList:        c.TokenWhiteList,
+		IncludeUnknownTokens:  c.IndexUnknownTokens,
+		WrappedNativeTokens:   c.WrappedNativeTokens,
+		IncludeZeroValueCalls: c.IncludeZeroValueCalls,
+		MetadataVerbosity:     c.MetadataVerbosity,
+	}
+}
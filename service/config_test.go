@@ -7,6 +7,8 @@ import (
 	ethtypes "github.com/ava-labs/coreth/core/types"
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanche-rosetta/mapper"
 )
 
 func TestConfig(t *testing.T) {
@@ -38,4 +40,22 @@ func TestConfig(t *testing.T) {
 		}
 		assert.IsType(t, ethtypes.NewLondonSigner(big.NewInt(1)), cfg.Signer())
 	})
+	t.Run("transaction options", func(t *testing.T) {
+		cfg := Config{
+			IngestionMode:         AnalyticsIngestion,
+			TokenWhiteList:        []string{"0x1"},
+			IndexUnknownTokens:    true,
+			WrappedNativeTokens:   []string{"0x2"},
+			IncludeZeroValueCalls: true,
+			MetadataVerbosity:     mapper.MetadataVerbositySummary,
+		}
+		assert.Equal(t, mapper.TransactionOptions{
+			AnalyticsMode:         true,
+			TokenWhiteList:        []string{"0x1"},
+			IncludeUnknownTokens:  true,
+			WrappedNativeTokens:   []string{"0x2"},
+			IncludeZeroValueCalls: true,
+			MetadataVerbosity:     mapper.MetadataVerbositySummary,
+		}, cfg.transactionOptions())
+	})
 }
@@ -1,7 +1,11 @@
 package service
 
 import (
+	"errors"
+
 	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
 )
 
 var (
@@ -19,6 +23,7 @@ var (
 		ErrCallInvalidMethod,
 		ErrCallInvalidParams,
 		ErrTransactionNotFound,
+		ErrChainAdvanced,
 	}
 
 	// General errors
@@ -34,6 +39,7 @@ var (
 	ErrCallInvalidMethod   = makeError(10, "Invalid call method", false)
 	ErrCallInvalidParams   = makeError(11, "invalid call params", false)
 	ErrTransactionNotFound = makeError(12, "Transaction was not found", true)
+	ErrChainAdvanced       = makeError(13, "Chain advanced while fetching UTXOs", true)
 )
 
 func makeError(code int32, message string, retriable bool) *types.Error {
@@ -46,6 +52,12 @@ func makeError(code int32, message string, retriable bool) *types.Error {
 }
 
 func WrapError(err *types.Error, message interface{}) *types.Error {
+	// Calls rejected while the node is unhealthy are reported as such,
+	// whatever the failing request
+	if t, ok := message.(error); ok && errors.Is(t, client.ErrUnavailable) {
+		err = ErrNotReady
+	}
+
 	newErr := makeError(err.Code, err.Message, err.Retriable)
 
 	if err.Description != nil {
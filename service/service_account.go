@@ -30,6 +30,7 @@ type AccountService struct {
 	client                client.Client
 	cChainAtomicTxBackend AccountBackend
 	pChainBackend         AccountBackend
+	xChainBackend         AccountBackend
 }
 
 // NewAccountService returns a new network servicer
@@ -37,6 +38,7 @@ func NewAccountService(
 	config *Config,
 	client client.Client,
 	pChainBackend AccountBackend,
+	xChainBackend AccountBackend,
 	cChainAtomicTxBackend AccountBackend,
 ) server.AccountAPIServicer {
 	return &AccountService{
@@ -44,6 +46,7 @@ func NewAccountService(
 		client:                client,
 		cChainAtomicTxBackend: cChainAtomicTxBackend,
 		pChainBackend:         pChainBackend,
+		xChainBackend:         xChainBackend,
 	}
 }
 
@@ -60,6 +63,10 @@ func (s AccountService) AccountBalance(
 		return s.pChainBackend.AccountBalance(ctx, req)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.AccountBalance(ctx, req)
+	}
+
 	if req.AccountIdentifier == nil {
 		return nil, WrapError(ErrInvalidInput, "account identifier is not provided")
 	}
@@ -155,6 +162,10 @@ func (s AccountService) AccountCoins(
 		return s.pChainBackend.AccountCoins(ctx, req)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.AccountCoins(ctx, req)
+	}
+
 	if s.cChainAtomicTxBackend.ShouldHandleRequest(req) {
 		return s.cChainAtomicTxBackend.AccountCoins(ctx, req)
 	}
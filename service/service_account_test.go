@@ -14,10 +14,12 @@ import (
 
 func TestAccountBalance(t *testing.T) {
 	pBackendMock := &mocks.AccountBackend{}
+	xBackendMock := &mocks.AccountBackend{}
 	cBackendMock := &mocks.AccountBackend{}
 	service := AccountService{
 		config:                &Config{Mode: ModeOnline},
 		pChainBackend:         pBackendMock,
+		xChainBackend:         xBackendMock,
 		cChainAtomicTxBackend: cBackendMock,
 	}
 	t.Run("p-chain request is delegated to p-chain backend", func(t *testing.T) {
@@ -44,6 +46,31 @@ func TestAccountBalance(t *testing.T) {
 		pBackendMock.AssertExpectations(t)
 	})
 
+	t.Run("x-chain request is delegated to x-chain backend", func(t *testing.T) {
+		req := &types.AccountBalanceRequest{
+			NetworkIdentifier: &types.NetworkIdentifier{
+				Network: mapper.FujiNetwork,
+				SubNetworkIdentifier: &types.SubNetworkIdentifier{
+					Network: mapper.XChainNetworkIdentifier,
+				},
+			},
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: "X-fuji15f9g0h5xkr5cp47n6u3qxj6yjtzzzrdr23a3tl",
+			},
+		}
+
+		expectedResp := &types.AccountBalanceResponse{}
+		pBackendMock.On("ShouldHandleRequest", req).Return(false)
+		xBackendMock.On("ShouldHandleRequest", req).Return(true)
+		xBackendMock.On("AccountBalance", mock.Anything, req).Return(expectedResp, nil)
+
+		resp, err := service.AccountBalance(context.Background(), req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResp, resp)
+		xBackendMock.AssertExpectations(t)
+	})
+
 	t.Run("c-chain atomic request is delegated to c-chain atomic tx backend", func(t *testing.T) {
 		req := &types.AccountBalanceRequest{
 			NetworkIdentifier: &types.NetworkIdentifier{
@@ -56,6 +83,7 @@ func TestAccountBalance(t *testing.T) {
 
 		expectedResp := &types.AccountBalanceResponse{}
 		pBackendMock.On("ShouldHandleRequest", req).Return(false)
+		xBackendMock.On("ShouldHandleRequest", req).Return(false)
 		cBackendMock.On("ShouldHandleRequest", req).Return(true)
 		cBackendMock.On("AccountBalance", mock.Anything, req).Return(expectedResp, nil)
 
@@ -69,11 +97,13 @@ func TestAccountBalance(t *testing.T) {
 
 func TestAccountCoins(t *testing.T) {
 	pBackendMock := &mocks.AccountBackend{}
+	xBackendMock := &mocks.AccountBackend{}
 	cBackendMock := &mocks.AccountBackend{}
 
 	service := AccountService{
 		config:                &Config{Mode: ModeOnline},
 		pChainBackend:         pBackendMock,
+		xChainBackend:         xBackendMock,
 		cChainAtomicTxBackend: cBackendMock,
 	}
 	t.Run("p-chain request is delegated to p-chain backend", func(t *testing.T) {
@@ -101,6 +131,31 @@ func TestAccountCoins(t *testing.T) {
 		pBackendMock.AssertExpectations(t)
 	})
 
+	t.Run("x-chain request is delegated to x-chain backend", func(t *testing.T) {
+		req := &types.AccountCoinsRequest{
+			NetworkIdentifier: &types.NetworkIdentifier{
+				Network: mapper.FujiNetwork,
+				SubNetworkIdentifier: &types.SubNetworkIdentifier{
+					Network: mapper.XChainNetworkIdentifier,
+				},
+			},
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: "X-fuji15f9g0h5xkr5cp47n6u3qxj6yjtzzzrdr23a3tl",
+			},
+		}
+
+		expectedResp := &types.AccountCoinsResponse{}
+		pBackendMock.On("ShouldHandleRequest", req).Return(false)
+		xBackendMock.On("ShouldHandleRequest", req).Return(true)
+		xBackendMock.On("AccountCoins", mock.Anything, req).Return(expectedResp, nil)
+
+		resp, err := service.AccountCoins(context.Background(), req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResp, resp)
+		xBackendMock.AssertExpectations(t)
+	})
+
 	t.Run("c-chain atomic request is delegated to c-chain atomic tx backend", func(t *testing.T) {
 		req := &types.AccountCoinsRequest{
 			NetworkIdentifier: &types.NetworkIdentifier{
@@ -114,6 +169,7 @@ func TestAccountCoins(t *testing.T) {
 		expectedResp := &types.AccountCoinsResponse{}
 
 		pBackendMock.On("ShouldHandleRequest", req).Return(false)
+		xBackendMock.On("ShouldHandleRequest", req).Return(false)
 		cBackendMock.On("ShouldHandleRequest", req).Return(true)
 		cBackendMock.On("AccountCoins", mock.Anything, req).Return(expectedResp, nil)
 
@@ -135,6 +191,7 @@ func TestAccountCoins(t *testing.T) {
 		}
 
 		pBackendMock.On("ShouldHandleRequest", req).Return(false)
+		xBackendMock.On("ShouldHandleRequest", req).Return(false)
 		cBackendMock.On("ShouldHandleRequest", req).Return(false)
 
 		resp, err := service.AccountCoins(context.Background(), req)
@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"strings"
+	"sync/atomic"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/coinbase/rosetta-sdk-go/server"
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/coinbase/rosetta-sdk-go/utils"
+	"golang.org/x/sync/errgroup"
 
 	corethTypes "github.com/ava-labs/coreth/core/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -28,8 +31,13 @@ type BlockService struct {
 	config        *Config
 	client        client.Client
 	pChainBackend BlockBackend
+	xChainBackend BlockBackend
 
 	genesisBlock *types.Block
+
+	// blockCache holds the accepted blocks served so far, up to lastAccepted
+	blockCache   *BlockCache
+	lastAccepted uint64
 }
 
 // NewBlockService returns a new block servicer
@@ -37,12 +45,16 @@ func NewBlockService(
 	config *Config,
 	c client.Client,
 	pChainBackend BlockBackend,
+	xChainBackend BlockBackend,
+	blockCache *BlockCache,
 ) server.BlockAPIServicer {
 	return &BlockService{
 		config:        config,
 		client:        c,
 		pChainBackend: pChainBackend,
+		xChainBackend: xChainBackend,
 		genesisBlock:  makeGenesisBlock(config.GenesisBlockHash),
+		blockCache:    blockCache,
 	}
 }
 
@@ -66,12 +78,25 @@ func (s *BlockService) Block(
 		return s.pChainBackend.Block(ctx, request)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(request) {
+		return s.xChainBackend.Block(ctx, request)
+	}
+
 	if s.isGenesisBlockRequest(request.BlockIdentifier) {
 		return &types.BlockResponse{
 			Block: s.genesisBlock,
 		}, nil
 	}
 
+	if block, ok := s.blockCache.Get(request.BlockIdentifier); ok {
+		return &types.BlockResponse{
+			Block: block,
+		}, nil
+	}
+
+	// read the traces and receipts from the node which served the block
+	ctx = client.WithPinnedNode(ctx)
+
 	var (
 		blockIdentifier       *types.BlockIdentifier
 		parentBlockIdentifier *types.BlockIdentifier
@@ -120,7 +145,7 @@ func (s *BlockService) Block(
 		return nil, terr
 	}
 
-	return &types.BlockResponse{
+	resp := &types.BlockResponse{
 		Block: &types.Block{
 			BlockIdentifier:       blockIdentifier,
 			ParentBlockIdentifier: parentBlockIdentifier,
@@ -128,7 +153,35 @@ func (s *BlockService) Block(
 			Transactions:          append(transactions, crosstx...),
 			Metadata:              mapper.BlockMetadata(block),
 		},
-	}, nil
+	}
+	s.cacheBlock(ctx, resp.Block)
+
+	return resp, nil
+}
+
+// cacheBlock caches [block] if it is at or below the last accepted height.
+// Blocks above the last accepted height known so far are only returned by
+// nodes allowing unfinalized queries, so it is refreshed before caching them.
+func (s *BlockService) cacheBlock(ctx context.Context, block *types.Block) {
+	if s.blockCache == nil {
+		return
+	}
+
+	height := uint64(block.BlockIdentifier.Index)
+	if height > atomic.LoadUint64(&s.lastAccepted) {
+		header, err := s.client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return
+		}
+
+		lastAccepted := header.Number.Uint64()
+		atomic.StoreUint64(&s.lastAccepted, lastAccepted)
+		if height > lastAccepted {
+			return
+		}
+	}
+
+	s.blockCache.Put(block)
 }
 
 // BlockTransaction implements the /block/transaction endpoint.
@@ -148,6 +201,19 @@ func (s *BlockService) BlockTransaction(
 		return s.pChainBackend.BlockTransaction(ctx, request)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(request) {
+		return s.xChainBackend.BlockTransaction(ctx, request)
+	}
+
+	if transaction, ok := s.blockCache.GetTransaction(request.BlockIdentifier, request.TransactionIdentifier.Hash); ok {
+		return &types.BlockTransactionResponse{
+			Transaction: transaction,
+		}, nil
+	}
+
+	// read the transaction from the node which served the block
+	ctx = client.WithPinnedNode(ctx)
+
 	header, err := s.client.HeaderByHash(ctx, ethcommon.HexToHash(request.BlockIdentifier.Hash))
 	if err != nil {
 		return nil, WrapError(ErrClientError, err)
@@ -188,8 +254,13 @@ func (s *BlockService) fetchTransactions(
 		return nil, WrapError(ErrClientError, err)
 	}
 
+	receipts, terr := s.fetchReceipts(ctx, block.Transactions())
+	if terr != nil {
+		return nil, terr
+	}
+
 	for i, tx := range block.Transactions() {
-		transaction, terr := s.fetchTransaction(ctx, tx, block.Header(), trace[i], flattened[i])
+		transaction, terr := s.mapTransaction(tx, block.Header(), receipts[i], trace[i], flattened[i])
 		if terr != nil {
 			return nil, terr
 		}
@@ -207,17 +278,68 @@ func (s *BlockService) fetchTransaction(
 	trace *client.Call,
 	flattened []*client.FlatCall,
 ) (*types.Transaction, *types.Error) {
-	msg, err := tx.AsMessage(s.config.Signer(), header.BaseFee)
+	receipt, err := s.client.TransactionReceipt(ctx, tx.Hash())
 	if err != nil {
 		return nil, WrapError(ErrClientError, err)
 	}
 
-	receipt, err := s.client.TransactionReceipt(ctx, tx.Hash())
+	return s.mapTransaction(tx, header, receipt, trace, flattened)
+}
+
+// fetchReceipts returns the receipts of the transactions of a block in a single
+// batched request, falling back to concurrent requests of each receipt when the
+// node rejects the batch
+func (s *BlockService) fetchReceipts(
+	ctx context.Context,
+	txs corethTypes.Transactions,
+) ([]*corethTypes.Receipt, *types.Error) {
+	if len(txs) == 0 {
+		return nil, nil
+	}
+
+	hashes := make([]ethcommon.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash()
+	}
+
+	receipts, err := s.client.TransactionReceipts(ctx, hashes)
+	if err == nil {
+		return receipts, nil
+	}
+	if !errors.Is(err, client.ErrBatchRejected) {
+		return nil, WrapError(ErrClientError, err)
+	}
+
+	receipts = make([]*corethTypes.Receipt, len(txs))
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(s.config.receiptFetchConcurrency())
+	for i, hash := range hashes {
+		i, hash := i, hash
+		eg.Go(func() (err error) {
+			receipts[i], err = s.client.TransactionReceipt(ctx, hash)
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, WrapError(ErrClientError, err)
+	}
+
+	return receipts, nil
+}
+
+func (s *BlockService) mapTransaction(
+	tx *corethTypes.Transaction,
+	header *corethTypes.Header,
+	receipt *corethTypes.Receipt,
+	trace *client.Call,
+	flattened []*client.FlatCall,
+) (*types.Transaction, *types.Error) {
+	msg, err := tx.AsMessage(s.config.Signer(), header.BaseFee)
 	if err != nil {
 		return nil, WrapError(ErrClientError, err)
 	}
 
-	transaction, err := mapper.Transaction(header, tx, &msg, receipt, trace, flattened, s.client, s.config.IsAnalyticsMode(), s.config.TokenWhiteList, s.config.IndexUnknownTokens)
+	transaction, err := mapper.Transaction(header, tx, &msg, receipt, trace, flattened, s.client, s.config.transactionOptions())
 	if err != nil {
 		return nil, WrapError(ErrInternalError, err)
 	}
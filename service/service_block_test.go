@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+)
+
+func TestFetchReceipts(t *testing.T) {
+	ctx := context.Background()
+	txs, hashes, receipts := makeReceiptFixtures(3)
+	batchRejected := fmt.Errorf("%w: 413 Request Entity Too Large", client.ErrBatchRejected)
+
+	t.Run("no transactions", func(t *testing.T) {
+		mockClient := &mocks.Client{}
+		service := BlockService{config: &Config{}, client: mockClient}
+
+		result, err := service.fetchReceipts(ctx, ethtypes.Transactions{})
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("batched request", func(t *testing.T) {
+		mockClient := &mocks.Client{}
+		service := BlockService{config: &Config{}, client: mockClient}
+		mockClient.On("TransactionReceipts", ctx, hashes).Return(receipts, nil).Once()
+
+		result, err := service.fetchReceipts(ctx, txs)
+		assert.Nil(t, err)
+		assert.Equal(t, receipts, result)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("falls back to concurrent requests", func(t *testing.T) {
+		mockClient := &mocks.Client{}
+		service := BlockService{config: &Config{ReceiptFetchConcurrency: 2}, client: mockClient}
+		mockClient.On("TransactionReceipts", ctx, hashes).Return(nil, batchRejected).Once()
+		for i, hash := range hashes {
+			mockClient.On("TransactionReceipt", mock.Anything, hash).Return(receipts[i], nil).Once()
+		}
+
+		result, err := service.fetchReceipts(ctx, txs)
+		assert.Nil(t, err)
+		assert.Equal(t, receipts, result)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("batch errors are reported", func(t *testing.T) {
+		mockClient := &mocks.Client{}
+		service := BlockService{config: &Config{}, client: mockClient}
+		mockClient.On("TransactionReceipts", ctx, hashes).Return(nil, errors.New("connection reset")).Once()
+
+		result, err := service.fetchReceipts(ctx, txs)
+		assert.Nil(t, result)
+		assert.Equal(t, ErrClientError.Code, err.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("fails when a receipt is unavailable", func(t *testing.T) {
+		mockClient := &mocks.Client{}
+		service := BlockService{config: &Config{}, client: mockClient}
+		mockClient.On("TransactionReceipts", ctx, hashes).Return(nil, batchRejected).Once()
+		mockClient.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+
+		result, err := service.fetchReceipts(ctx, txs)
+		assert.Nil(t, result)
+		assert.Equal(t, ErrClientError.Code, err.Code)
+	})
+}
+
+func TestCacheBlock(t *testing.T) {
+	ctx := context.Background()
+	makeBlock := func(index int64) *types.Block {
+		return &types.Block{BlockIdentifier: &types.BlockIdentifier{Index: index, Hash: fmt.Sprintf("0x%d", index)}}
+	}
+
+	mockClient := &mocks.Client{}
+	blockCache := NewBlockCache(8)
+	service := BlockService{config: &Config{}, client: mockClient, blockCache: blockCache}
+	mockClient.On("HeaderByNumber", ctx, (*big.Int)(nil)).Return(&ethtypes.Header{Number: big.NewInt(10)}, nil).Twice()
+
+	// The last accepted height is fetched for blocks above the known one
+	service.cacheBlock(ctx, makeBlock(10))
+	_, ok := blockCache.Get(&types.PartialBlockIdentifier{Index: types.Int64(10)})
+	assert.True(t, ok)
+
+	// Blocks below the known last accepted height are cached right away
+	service.cacheBlock(ctx, makeBlock(9))
+	_, ok = blockCache.Get(&types.PartialBlockIdentifier{Index: types.Int64(9)})
+	assert.True(t, ok)
+
+	// Unfinalized blocks are not cached
+	service.cacheBlock(ctx, makeBlock(11))
+	_, ok = blockCache.Get(&types.PartialBlockIdentifier{Index: types.Int64(11)})
+	assert.False(t, ok)
+
+	mockClient.AssertExpectations(t)
+}
+
+// BenchmarkFetchReceipts compares the receipt requests of a 200 transaction
+// block sent by the client to a JSON-RPC server, each HTTP request taking
+// a millisecond round-trip
+func BenchmarkFetchReceipts(b *testing.B) {
+	const (
+		txCount   = 200
+		roundTrip = time.Millisecond
+	)
+	ctx := context.Background()
+	txs, hashes, receipts := makeReceiptFixtures(txCount)
+
+	newClient := func(batched bool) client.Client {
+		server := httptest.NewServer(newReceiptServer(b, receipts, batched, roundTrip))
+		b.Cleanup(server.Close)
+
+		c, err := client.NewClient(ctx, server.URL, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		return c
+	}
+
+	b.Run("sequential", func(b *testing.B) {
+		c := newClient(false)
+		for n := 0; n < b.N; n++ {
+			for _, hash := range hashes {
+				if _, err := c.TransactionReceipt(ctx, hash); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		service := BlockService{config: &Config{}, client: newClient(false)}
+		for n := 0; n < b.N; n++ {
+			if _, err := service.fetchReceipts(ctx, txs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("batched", func(b *testing.B) {
+		service := BlockService{config: &Config{}, client: newClient(true)}
+		for n := 0; n < b.N; n++ {
+			if _, err := service.fetchReceipts(ctx, txs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// newReceiptServer returns a JSON-RPC handler serving eth_getTransactionReceipt
+// for [receipts], which rejects batched requests unless [batched] is set
+func newReceiptServer(
+	b *testing.B,
+	receipts []*ethtypes.Receipt,
+	batched bool,
+	roundTrip time.Duration,
+) http.HandlerFunc {
+	type request struct {
+		ID     json.RawMessage `json:"id"`
+		Params []common.Hash   `json:"params"`
+	}
+	type response struct {
+		Version string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  json.RawMessage `json:"result"`
+	}
+
+	receiptsJSON := make(map[common.Hash]json.RawMessage, len(receipts))
+	for _, receipt := range receipts {
+		receiptJSON, err := json.Marshal(receipt)
+		if err != nil {
+			b.Fatal(err)
+		}
+		receiptsJSON[receipt.TxHash] = receiptJSON
+	}
+	answer := func(req request) response {
+		return response{Version: "2.0", ID: req.ID, Result: receiptsJSON[req.Params[0]]}
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(roundTrip)
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			b.Error(err)
+			return
+		}
+
+		if len(body) > 0 && body[0] == '[' {
+			if !batched {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			var reqs []request
+			if err := json.Unmarshal(body, &reqs); err != nil {
+				b.Error(err)
+				return
+			}
+			resps := make([]response, len(reqs))
+			for i, req := range reqs {
+				resps[i] = answer(req)
+			}
+			_ = json.NewEncoder(w).Encode(resps)
+			return
+		}
+
+		var req request
+		if err := json.Unmarshal(body, &req); err != nil {
+			b.Error(err)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(answer(req))
+	}
+}
+
+func makeReceiptFixtures(count int) (ethtypes.Transactions, []common.Hash, []*ethtypes.Receipt) {
+	txs := make(ethtypes.Transactions, count)
+	hashes := make([]common.Hash, count)
+	receipts := make([]*ethtypes.Receipt, count)
+	for i := range txs {
+		txs[i] = ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: uint64(i)})
+		hashes[i] = txs[i].Hash()
+
+		// Each transaction emits an ERC-20 transfer, as most C-chain transactions do
+		transfer := &ethtypes.Log{
+			Address: common.HexToAddress("0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7"),
+			Topics: []common.Hash{
+				common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+				common.BigToHash(big.NewInt(int64(i))),
+				common.BigToHash(big.NewInt(int64(i + 1))),
+			},
+			Data:    common.BigToHash(big.NewInt(1_000_000)).Bytes(),
+			TxHash:  hashes[i],
+			TxIndex: uint(i),
+			Index:   uint(i),
+		}
+		receipts[i] = &ethtypes.Receipt{
+			Type:              ethtypes.LegacyTxType,
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(i+1) * 50_000,
+			GasUsed:           50_000,
+			Logs:              []*ethtypes.Log{transfer},
+			Bloom:             ethtypes.CreateBloom(ethtypes.Receipts{{Logs: []*ethtypes.Log{transfer}}}),
+			TxHash:            hashes[i],
+			TransactionIndex:  uint(i),
+		}
+	}
+	return txs, hashes, receipts
+}
@@ -5,15 +5,26 @@ import (
 	"encoding/json"
 
 	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
 	"github.com/coinbase/rosetta-sdk-go/server"
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// CallBackend represents a backend that implements /call family of apis for a subset of requests
+type CallBackend interface {
+	// ShouldHandleRequest returns whether a given request should be handled by this backend
+	ShouldHandleRequest(req interface{}) bool
+	// Call implements /call endpoint
+	Call(ctx context.Context, req *types.CallRequest) (*types.CallResponse, *types.Error)
+}
+
 // CallService implements /call/* endpoints
 type CallService struct {
-	config *Config
-	client client.Client
+	config        *Config
+	client        client.Client
+	pChainBackend CallBackend
 }
 
 // GetTransactionReceiptInput is the input to the call
@@ -22,11 +33,18 @@ type GetTransactionReceiptInput struct {
 	TxHash string `json:"tx_hash"`
 }
 
+// GetTransactionMetadataInput is the input to the call
+// method "eth_getTransactionMetadata".
+type GetTransactionMetadataInput struct {
+	TxHash string `json:"tx_hash"`
+}
+
 // NewCallService returns a new call servicer
-func NewCallService(config *Config, client client.Client) server.CallAPIServicer {
+func NewCallService(config *Config, client client.Client, pChainBackend CallBackend) server.CallAPIServicer {
 	return &CallService{
-		config: config,
-		client: client,
+		config:        config,
+		client:        client,
+		pChainBackend: pChainBackend,
 	}
 }
 
@@ -39,9 +57,18 @@ func (s CallService) Call(
 		return nil, ErrUnavailableOffline
 	}
 
+	if s.pChainBackend.ShouldHandleRequest(req) {
+		return s.pChainBackend.Call(ctx, req)
+	}
+	if xmapper.IsXChain(req.NetworkIdentifier) {
+		return nil, WrapError(ErrNotSupported, "x-chain calls are not supported")
+	}
+
 	switch req.Method {
 	case "eth_getTransactionReceipt":
 		return s.callGetTransactionReceipt(ctx, req)
+	case mapper.CallGetTransactionMetadata:
+		return s.callGetTransactionMetadata(ctx, req)
 	default:
 		return nil, ErrCallInvalidMethod
 	}
@@ -77,3 +104,39 @@ func (s CallService) callGetTransactionReceipt(
 
 	return &types.CallResponse{Result: receiptMap}, nil
 }
+
+// callGetTransactionMetadata returns the full receipt and trace of a transaction,
+// which are left out of /block responses at lower metadata verbosities
+func (s CallService) callGetTransactionMetadata(
+	ctx context.Context,
+	req *types.CallRequest,
+) (*types.CallResponse, *types.Error) {
+	var input GetTransactionMetadataInput
+	if err := types.UnmarshalMap(req.Parameters, &input); err != nil {
+		return nil, WrapError(ErrCallInvalidParams, err)
+	}
+
+	if len(input.TxHash) == 0 {
+		return nil, WrapError(ErrCallInvalidParams, "tx_hash missing from params")
+	}
+
+	receipt, err := s.client.TransactionReceipt(ctx, common.HexToHash(input.TxHash))
+	if err != nil {
+		return nil, WrapError(ErrClientError, err)
+	}
+
+	trace, _, err := s.client.TraceTransaction(ctx, input.TxHash)
+	if err != nil {
+		return nil, WrapError(ErrClientError, err)
+	}
+
+	result, err := types.MarshalMap(map[string]interface{}{
+		"receipt": receipt,
+		"trace":   trace,
+	})
+	if err != nil {
+		return nil, WrapError(ErrInternalError, err)
+	}
+
+	return &types.CallResponse{Result: result}, nil
+}
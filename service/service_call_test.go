@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+	serviceMocks "github.com/ava-labs/avalanche-rosetta/mocks/service"
+)
+
+func TestCallGetTransactionMetadata(t *testing.T) {
+	ctx := context.Background()
+	txHash := common.HexToHash("0x0d8d2aed5a61a80e1325fc8c5e6f1e3a1d0bd4b6b1e1c1f3dba9d3c53ce9b3b1")
+
+	newService := func() (CallService, *mocks.Client, *serviceMocks.CallBackend) {
+		mockClient := &mocks.Client{}
+		pBackendMock := &serviceMocks.CallBackend{}
+		pBackendMock.On("ShouldHandleRequest", mock.Anything).Return(false)
+		service := CallService{
+			config:        &Config{Mode: ModeOnline},
+			client:        mockClient,
+			pChainBackend: pBackendMock,
+		}
+		return service, mockClient, pBackendMock
+	}
+	newRequest := func(params map[string]interface{}) *types.CallRequest {
+		return &types.CallRequest{
+			NetworkIdentifier: &types.NetworkIdentifier{Network: mapper.FujiNetwork},
+			Method:            mapper.CallGetTransactionMetadata,
+			Parameters:        params,
+		}
+	}
+
+	t.Run("returns the receipt and trace", func(t *testing.T) {
+		service, mockClient, _ := newService()
+		receipt := &ethtypes.Receipt{
+			Status:  ethtypes.ReceiptStatusSuccessful,
+			GasUsed: 21_000,
+			TxHash:  txHash,
+		}
+		trace := &client.Call{
+			Type: mapper.OpCall,
+			From: common.HexToAddress("0x1"),
+			To:   common.HexToAddress("0x2"),
+		}
+		mockClient.On("TransactionReceipt", ctx, txHash).Return(receipt, nil).Once()
+		mockClient.On("TraceTransaction", ctx, txHash.Hex()).Return(trace, []*client.FlatCall{}, nil).Once()
+
+		resp, err := service.Call(ctx, newRequest(map[string]interface{}{"tx_hash": txHash.Hex()}))
+		assert.Nil(t, err)
+
+		// The result is checked as served, once serialized
+		resultBytes, marshalErr := json.Marshal(resp.Result)
+		assert.NoError(t, marshalErr)
+		var result map[string]interface{}
+		assert.NoError(t, json.Unmarshal(resultBytes, &result))
+
+		receiptResult := result["receipt"].(map[string]interface{})
+		assert.Equal(t, txHash.Hex(), receiptResult["transactionHash"])
+		assert.Equal(t, "0x5208", receiptResult["gasUsed"])
+		assert.Equal(t, "0x1", receiptResult["status"])
+
+		traceResult := result["trace"].(map[string]interface{})
+		assert.Equal(t, mapper.OpCall, traceResult["type"])
+		assert.Equal(t, common.HexToAddress("0x2").Hex(), traceResult["to"])
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("x-chain calls are not supported", func(t *testing.T) {
+		service, mockClient, _ := newService()
+		req := newRequest(map[string]interface{}{"tx_hash": txHash.Hex()})
+		req.NetworkIdentifier.SubNetworkIdentifier = &types.SubNetworkIdentifier{Network: mapper.XChainNetworkIdentifier}
+
+		resp, err := service.Call(ctx, req)
+		assert.Nil(t, resp)
+		assert.Equal(t, ErrNotSupported.Code, err.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("requires a tx_hash", func(t *testing.T) {
+		service, mockClient, _ := newService()
+
+		resp, err := service.Call(ctx, newRequest(map[string]interface{}{}))
+		assert.Nil(t, resp)
+		assert.Equal(t, ErrCallInvalidParams.Code, err.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("client errors are reported", func(t *testing.T) {
+		service, mockClient, _ := newService()
+		mockClient.On("TransactionReceipt", ctx, txHash).Return(nil, errors.New("connection refused")).Once()
+
+		resp, err := service.Call(ctx, newRequest(map[string]interface{}{"tx_hash": txHash.Hex()}))
+		assert.Nil(t, resp)
+		assert.Equal(t, ErrClientError.Code, err.Code)
+
+		mockClient.On("TransactionReceipt", ctx, txHash).Return(&ethtypes.Receipt{TxHash: txHash}, nil).Once()
+		mockClient.On("TraceTransaction", ctx, txHash.Hex()).Return(nil, nil, errors.New("tracer timed out")).Once()
+
+		resp, err = service.Call(ctx, newRequest(map[string]interface{}{"tx_hash": txHash.Hex()}))
+		assert.Nil(t, resp)
+		assert.Equal(t, ErrClientError.Code, err.Code)
+		mockClient.AssertExpectations(t)
+	})
+}
@@ -48,6 +48,7 @@ type ConstructionService struct {
 	client                client.Client
 	cChainAtomicTxBackend ConstructionBackend
 	pChainBackend         ConstructionBackend
+	xChainBackend         ConstructionBackend
 }
 
 // NewConstructionService returns a new construction servicer
@@ -55,6 +56,7 @@ func NewConstructionService(
 	config *Config,
 	client client.Client,
 	pChainBackend ConstructionBackend,
+	xChainBackend ConstructionBackend,
 	cChainAtomicTxBackend ConstructionBackend,
 ) server.ConstructionAPIServicer {
 	return &ConstructionService{
@@ -62,6 +64,7 @@ func NewConstructionService(
 		client:                client,
 		cChainAtomicTxBackend: cChainAtomicTxBackend,
 		pChainBackend:         pChainBackend,
+		xChainBackend:         xChainBackend,
 	}
 }
 
@@ -83,6 +86,10 @@ func (s ConstructionService) ConstructionMetadata(
 		return s.pChainBackend.ConstructionMetadata(ctx, req)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.ConstructionMetadata(ctx, req)
+	}
+
 	if s.cChainAtomicTxBackend.ShouldHandleRequest(req) {
 		return s.cChainAtomicTxBackend.ConstructionMetadata(ctx, req)
 	}
@@ -107,23 +114,6 @@ func (s ConstructionService) ConstructionMetadata(
 		nonce = input.Nonce.Uint64()
 	}
 
-	var gasPrice *big.Int
-	if input.GasPrice == nil {
-		if gasPrice, err = s.client.SuggestGasPrice(ctx); err != nil {
-			return nil, WrapError(ErrClientError, err)
-		}
-
-		if input.SuggestedFeeMultiplier != nil {
-			newGasPrice := new(big.Float).Mul(
-				big.NewFloat(*input.SuggestedFeeMultiplier),
-				new(big.Float).SetInt(gasPrice),
-			)
-			newGasPrice.Int(gasPrice)
-		}
-	} else {
-		gasPrice = input.GasPrice
-	}
-
 	var gasLimit uint64
 	if input.GasLimit == nil {
 		if input.Currency == nil || utils.Equal(input.Currency, mapper.AvaxCurrency) {
@@ -141,20 +131,37 @@ func (s ConstructionService) ConstructionMetadata(
 
 	metadata := &metadata{
 		Nonce:    nonce,
-		GasPrice: gasPrice,
 		GasLimit: gasLimit,
 	}
 
+	// Legacy transactions are built when explicitly requested or when a
+	// gas price is provided, otherwise an EIP-1559 transaction is built.
+	var feePerGas *big.Int
+	if input.Legacy || input.GasPrice != nil {
+		metadata.GasPrice, err = s.getGasPrice(ctx, input)
+		if err != nil {
+			return nil, WrapError(ErrClientError, err)
+		}
+		feePerGas = metadata.GasPrice
+	} else {
+		var ferr *types.Error
+		metadata.MaxFeePerGas, metadata.MaxPriorityFeePerGas, ferr = s.getDynamicFees(ctx, input)
+		if ferr != nil {
+			return nil, ferr
+		}
+		feePerGas = metadata.MaxFeePerGas
+	}
+
 	metadataMap, err := mapper.MarshalJSONMap(metadata)
 	if err != nil {
 		return nil, WrapError(ErrInternalError, err)
 	}
 
-	suggestedFee := gasPrice.Int64() * int64(gasLimit)
+	suggestedFee := new(big.Int).Mul(feePerGas, new(big.Int).SetUint64(gasLimit))
 	return &types.ConstructionMetadataResponse{
 		Metadata: metadataMap,
 		SuggestedFee: []*types.Amount{
-			mapper.AvaxAmount(big.NewInt(suggestedFee)),
+			mapper.AvaxAmount(suggestedFee),
 		},
 	}, nil
 }
@@ -174,6 +181,10 @@ func (s ConstructionService) ConstructionHash(
 		return s.pChainBackend.ConstructionHash(ctx, req)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.ConstructionHash(ctx, req)
+	}
+
 	if s.cChainAtomicTxBackend.ShouldHandleRequest(req) {
 		return s.cChainAtomicTxBackend.ConstructionHash(ctx, req)
 	}
@@ -215,6 +226,10 @@ func (s ConstructionService) ConstructionCombine(
 		return s.pChainBackend.ConstructionCombine(ctx, req)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.ConstructionCombine(ctx, req)
+	}
+
 	if s.cChainAtomicTxBackend.ShouldHandleRequest(req) {
 		return s.cChainAtomicTxBackend.ConstructionCombine(ctx, req)
 	}
@@ -224,14 +239,7 @@ func (s ConstructionService) ConstructionCombine(
 		return nil, WrapError(ErrInvalidInput, err)
 	}
 
-	ethTransaction := ethtypes.NewTransaction(
-		unsignedTx.Nonce,
-		ethcommon.HexToAddress(unsignedTx.To),
-		unsignedTx.Value,
-		unsignedTx.GasLimit,
-		unsignedTx.GasPrice,
-		unsignedTx.Data,
-	)
+	ethTransaction := unsignedTx.ethTransaction()
 
 	signer := ethtypes.LatestSignerForChainID(unsignedTx.ChainID)
 	signedTx, err := ethTransaction.WithSignature(signer, req.Signatures[0].Bytes)
@@ -272,6 +280,10 @@ func (s ConstructionService) ConstructionDerive(
 		return s.pChainBackend.ConstructionDerive(ctx, req)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.ConstructionDerive(ctx, req)
+	}
+
 	if s.cChainAtomicTxBackend.ShouldHandleRequest(req) {
 		return s.cChainAtomicTxBackend.ConstructionDerive(ctx, req)
 	}
@@ -301,6 +313,10 @@ func (s ConstructionService) ConstructionParse(
 		return s.pChainBackend.ConstructionParse(ctx, req)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.ConstructionParse(ctx, req)
+	}
+
 	if s.cChainAtomicTxBackend.ShouldHandleRequest(req) {
 		return s.cChainAtomicTxBackend.ConstructionParse(ctx, req)
 	}
@@ -326,8 +342,13 @@ func (s ConstructionService) ConstructionParse(
 		tx.Value = t.Value()
 		tx.Data = t.Data()
 		tx.Nonce = t.Nonce()
-		tx.GasPrice = t.GasPrice()
 		tx.GasLimit = t.Gas()
+		if t.Type() == ethtypes.DynamicFeeTxType {
+			tx.MaxFeePerGas = t.GasFeeCap()
+			tx.MaxPriorityFeePerGas = t.GasTipCap()
+		} else {
+			tx.GasPrice = t.GasPrice()
+		}
 		tx.ChainID = s.config.ChainID
 		tx.Currency = wrappedTx.Currency
 
@@ -404,10 +425,12 @@ func (s ConstructionService) ConstructionParse(
 	}
 
 	metadata := &parseMetadata{
-		Nonce:    tx.Nonce,
-		GasPrice: tx.GasPrice,
-		GasLimit: tx.GasLimit,
-		ChainID:  tx.ChainID,
+		Nonce:                tx.Nonce,
+		GasPrice:             tx.GasPrice,
+		GasLimit:             tx.GasLimit,
+		ChainID:              tx.ChainID,
+		MaxFeePerGas:         tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
 	}
 	metaMap, err := mapper.MarshalJSONMap(metadata)
 	if err != nil {
@@ -452,6 +475,10 @@ func (s ConstructionService) ConstructionPayloads(
 		return s.pChainBackend.ConstructionPayloads(ctx, req)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.ConstructionPayloads(ctx, req)
+	}
+
 	if s.cChainAtomicTxBackend.ShouldHandleRequest(req) {
 		return s.cChainAtomicTxBackend.ConstructionPayloads(ctx, req)
 	}
@@ -478,10 +505,6 @@ func (s ConstructionService) ConstructionPayloads(
 
 	toOp, amount := matches[1].First()
 	toAddress := toOp.Account.Address
-	nonce := metadata.Nonce
-	gasPrice := metadata.GasPrice
-	gasLimit := metadata.GasLimit
-	chainID := s.config.ChainID
 
 	fromOp, _ := matches[0].First()
 	fromAddress := fromOp.Account.Address
@@ -513,26 +536,20 @@ func (s ConstructionService) ConstructionPayloads(
 		amount = big.NewInt(0)
 	}
 
-	tx := ethtypes.NewTransaction(
-		nonce,
-		sendToAddress,
-		amount,
-		gasLimit,
-		gasPrice,
-		transferData,
-	)
-
 	unsignedTx := &transaction{
-		From:     checkFrom,
-		To:       sendToAddress.Hex(),
-		Value:    amount,
-		Data:     tx.Data(),
-		Nonce:    tx.Nonce(),
-		GasPrice: gasPrice,
-		GasLimit: tx.Gas(),
-		ChainID:  chainID,
-		Currency: fromCurrency,
-	}
+		From:                 checkFrom,
+		To:                   sendToAddress.Hex(),
+		Value:                amount,
+		Data:                 transferData,
+		Nonce:                metadata.Nonce,
+		GasPrice:             metadata.GasPrice,
+		GasLimit:             metadata.GasLimit,
+		ChainID:              s.config.ChainID,
+		Currency:             fromCurrency,
+		MaxFeePerGas:         metadata.MaxFeePerGas,
+		MaxPriorityFeePerGas: metadata.MaxPriorityFeePerGas,
+	}
+	tx := unsignedTx.ethTransaction()
 
 	payload := &types.SigningPayload{
 		AccountIdentifier: &types.AccountIdentifier{Address: checkFrom},
@@ -562,6 +579,10 @@ func (s ConstructionService) ConstructionPreprocess(
 	if s.pChainBackend.ShouldHandleRequest(req) {
 		return s.pChainBackend.ConstructionPreprocess(ctx, req)
 	}
+
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.ConstructionPreprocess(ctx, req)
+	}
 	if s.cChainAtomicTxBackend.ShouldHandleRequest(req) {
 		return s.cChainAtomicTxBackend.ConstructionPreprocess(ctx, req)
 	}
@@ -638,6 +659,35 @@ func (s ConstructionService) ConstructionPreprocess(
 		}
 		preprocessOptions.Nonce = bigObj
 	}
+	if v, ok := req.Metadata["max_fee_per_gas"]; ok {
+		stringObj, ok := v.(string)
+		if !ok {
+			return nil, WrapError(ErrInvalidInput, fmt.Errorf("%s is not a valid max fee per gas string", v))
+		}
+		bigObj, ok := new(big.Int).SetString(stringObj, 10)
+		if !ok {
+			return nil, WrapError(ErrInvalidInput, fmt.Errorf("%s is not a valid max fee per gas", v))
+		}
+		preprocessOptions.MaxFeePerGas = bigObj
+	}
+	if v, ok := req.Metadata["max_priority_fee_per_gas"]; ok {
+		stringObj, ok := v.(string)
+		if !ok {
+			return nil, WrapError(ErrInvalidInput, fmt.Errorf("%s is not a valid max priority fee per gas string", v))
+		}
+		bigObj, ok := new(big.Int).SetString(stringObj, 10)
+		if !ok {
+			return nil, WrapError(ErrInvalidInput, fmt.Errorf("%s is not a valid max priority fee per gas", v))
+		}
+		preprocessOptions.MaxPriorityFeePerGas = bigObj
+	}
+	if v, ok := req.Metadata["legacy"]; ok {
+		legacy, ok := v.(bool)
+		if !ok {
+			return nil, WrapError(ErrInvalidInput, fmt.Errorf("%v is not a valid legacy flag", v))
+		}
+		preprocessOptions.Legacy = legacy
+	}
 
 	marshaled, err := mapper.MarshalJSONMap(preprocessOptions)
 	if err != nil {
@@ -668,6 +718,10 @@ func (s ConstructionService) ConstructionSubmit(
 		return s.pChainBackend.ConstructionSubmit(ctx, req)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(req) {
+		return s.xChainBackend.ConstructionSubmit(ctx, req)
+	}
+
 	if s.cChainAtomicTxBackend.ShouldHandleRequest(req) {
 		return s.cChainAtomicTxBackend.ConstructionSubmit(ctx, req)
 	}
@@ -755,6 +809,70 @@ func (s ConstructionService) createOperationDescription(
 	}
 }
 
+// getGasPrice returns the gas price of a legacy transaction, applying the
+// suggested fee multiplier to the node suggestion if no gas price is provided.
+func (s ConstructionService) getGasPrice(ctx context.Context, input options) (*big.Int, error) {
+	if input.GasPrice != nil {
+		return input.GasPrice, nil
+	}
+
+	gasPrice, err := s.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.SuggestedFeeMultiplier != nil {
+		gasPrice = applyFeeMultiplier(gasPrice, *input.SuggestedFeeMultiplier)
+	}
+
+	return gasPrice, nil
+}
+
+// getDynamicFees returns the max fee per gas and the max priority fee per gas
+// of an EIP-1559 transaction. Unless provided, the max fee covers the estimated
+// base fee, scaled by the suggested fee multiplier, plus the priority fee.
+func (s ConstructionService) getDynamicFees(ctx context.Context, input options) (*big.Int, *big.Int, *types.Error) {
+	maxPriorityFeePerGas := input.MaxPriorityFeePerGas
+	if maxPriorityFeePerGas == nil {
+		tip, err := s.client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, nil, WrapError(ErrClientError, err)
+		}
+		maxPriorityFeePerGas = tip
+	}
+
+	maxFeePerGas := input.MaxFeePerGas
+	if maxFeePerGas == nil {
+		baseFee, err := s.client.EstimateBaseFee(ctx)
+		if err != nil {
+			return nil, nil, WrapError(ErrClientError, err)
+		}
+
+		if input.SuggestedFeeMultiplier != nil {
+			baseFee = applyFeeMultiplier(baseFee, *input.SuggestedFeeMultiplier)
+		}
+		maxFeePerGas = new(big.Int).Add(baseFee, maxPriorityFeePerGas)
+	}
+
+	if maxPriorityFeePerGas.Cmp(maxFeePerGas) > 0 {
+		return nil, nil, WrapError(
+			ErrInvalidInput,
+			fmt.Errorf("max priority fee per gas %s is higher than max fee per gas %s", maxPriorityFeePerGas, maxFeePerGas),
+		)
+	}
+
+	return maxFeePerGas, maxPriorityFeePerGas, nil
+}
+
+func applyFeeMultiplier(value *big.Int, multiplier float64) *big.Int {
+	result, _ := new(big.Float).Mul(
+		big.NewFloat(multiplier),
+		new(big.Float).SetInt(value),
+	).Int(nil)
+
+	return result
+}
+
 func (s ConstructionService) getNativeTransferGasLimit(
 	ctx context.Context,
 	to string,
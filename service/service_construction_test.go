@@ -9,10 +9,12 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	ethtypes "github.com/ava-labs/coreth/core/types"
 	"github.com/ava-labs/coreth/interfaces"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ava-labs/avalanche-rosetta/mapper"
@@ -38,6 +40,7 @@ func TestConstructionMetadata(t *testing.T) {
 		config:                &Config{Mode: ModeOnline},
 		client:                client,
 		pChainBackend:         skippedBackend,
+		xChainBackend:         skippedBackend,
 		cChainAtomicTxBackend: skippedBackend,
 	}
 
@@ -66,7 +69,69 @@ func TestConstructionMetadata(t *testing.T) {
 		assert.Equal(t, "from address is not provided", err.Details["error"])
 	})
 
-	t.Run("basic native transfer", func(t *testing.T) {
+	t.Run("basic dynamic fee native transfer", func(t *testing.T) {
+		to := common.HexToAddress(defaultToAddress)
+		client.On(
+			"NonceAt",
+			ctx,
+			common.HexToAddress(defaultFromAddress),
+			(*big.Int)(nil),
+		).Return(
+			uint64(0),
+			nil,
+		).Once()
+		client.On(
+			"EstimateBaseFee",
+			ctx,
+		).Return(
+			big.NewInt(25000000000),
+			nil,
+		).Once()
+		client.On(
+			"SuggestGasTipCap",
+			ctx,
+		).Return(
+			big.NewInt(1000000000),
+			nil,
+		).Once()
+		client.On(
+			"EstimateGas",
+			ctx,
+			interfaces.CallMsg{
+				From:  common.HexToAddress(defaultFromAddress),
+				To:    &to,
+				Value: big.NewInt(42894881044106498),
+			},
+		).Return(
+			uint64(21001),
+			nil,
+		).Once()
+		input := map[string]interface{}{"from": defaultFromAddress, "to": defaultToAddress, "value": "0x9864aac3510d02"}
+		resp, err := service.ConstructionMetadata(
+			ctx,
+			&types.ConstructionMetadataRequest{
+				Options: input,
+			},
+		)
+		assert.Nil(t, err)
+		metadata := &metadata{
+			MaxFeePerGas:         big.NewInt(26000000000),
+			MaxPriorityFeePerGas: big.NewInt(1000000000),
+			GasLimit:             21_001,
+			Nonce:                0,
+		}
+		assert.Equal(t, &types.ConstructionMetadataResponse{
+			Metadata: forceMarshalMap(t, metadata),
+			SuggestedFee: []*types.Amount{
+				{
+					Value:    "546026000000000",
+					Currency: mapper.AvaxCurrency,
+				},
+			},
+		}, resp)
+	})
+
+	t.Run("basic legacy native transfer", func(t *testing.T) {
 		to := common.HexToAddress(defaultToAddress)
 		client.On(
 			"NonceAt",
@@ -96,7 +161,7 @@ func TestConstructionMetadata(t *testing.T) {
 			uint64(21001),
 			nil,
 		).Once()
-		input := map[string]interface{}{"from": "0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309", "to": "0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d", "value": "0x9864aac3510d02"}
+		input := map[string]interface{}{"from": "0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309", "to": "0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d", "value": "0x9864aac3510d02", "legacy": true}
 		resp, err := service.ConstructionMetadata(
 			ctx,
 			&types.ConstructionMetadataRequest{
@@ -119,7 +184,7 @@ func TestConstructionMetadata(t *testing.T) {
 			},
 		}, resp)
 	})
-	t.Run("basic erc20 transfer", func(t *testing.T) {
+	t.Run("basic legacy erc20 transfer", func(t *testing.T) {
 		contractAddress := common.HexToAddress(defaultContractAddress)
 		client.On(
 			"NonceAt",
@@ -157,7 +222,7 @@ func TestConstructionMetadata(t *testing.T) {
 			"decimals": defaultDecimals,
 			"metadata": currencyMetadata,
 		}
-		input := map[string]interface{}{"from": defaultFromAddress, "to": "0x920eb8ca79f07eb3bfc39c324c8113948ed3104c", "value": "0xb4d360e3", "currency": currency}
+		input := map[string]interface{}{"from": defaultFromAddress, "to": "0x920eb8ca79f07eb3bfc39c324c8113948ed3104c", "value": "0xb4d360e3", "currency": currency, "legacy": true}
 		resp, err := service.ConstructionMetadata(
 			ctx,
 			&types.ConstructionMetadataRequest{
@@ -188,6 +253,7 @@ func TestContructionHash(t *testing.T) {
 
 	service := ConstructionService{
 		pChainBackend:         skippedBackend,
+		xChainBackend:         skippedBackend,
 		cChainAtomicTxBackend: skippedBackend,
 	}
 
@@ -257,6 +323,7 @@ func TestConstructionDerive(t *testing.T) {
 	skippedBackend.On("ShouldHandleRequest", mock.Anything).Return(false)
 	service := ConstructionService{
 		pChainBackend:         skippedBackend,
+		xChainBackend:         skippedBackend,
 		cChainAtomicTxBackend: skippedBackend,
 	}
 
@@ -329,6 +396,7 @@ func TestPreprocessMetadata(t *testing.T) {
 		config:                &Config{Mode: ModeOnline},
 		client:                client,
 		pChainBackend:         skippedBackend,
+		xChainBackend:         skippedBackend,
 		cChainAtomicTxBackend: skippedBackend,
 	}
 	intent := `[{"operation_identifier":{"index":0},"type":"CALL","account":{"address":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309"},"amount":{"value":"-42894881044106498","currency":{"symbol":"AVAX","decimals":18}}},{"operation_identifier":{"index":1},"type":"CALL","account":{"address":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d"},"amount":{"value":"42894881044106498","currency":{"symbol":"AVAX","decimals":18}}}]`
@@ -347,7 +415,7 @@ func TestPreprocessMetadata(t *testing.T) {
 		assert.Nil(t, preprocessResponse)
 		assert.Equal(t, "currency info doesn't match between the operations", err.Details["error"])
 	})
-	t.Run("basic flow", func(t *testing.T) {
+	t.Run("basic legacy flow", func(t *testing.T) {
 		var ops []*types.Operation
 		assert.NoError(t, json.Unmarshal([]byte(intent), &ops))
 		preprocessResponse, err := service.ConstructionPreprocess(
@@ -355,10 +423,13 @@ func TestPreprocessMetadata(t *testing.T) {
 			&types.ConstructionPreprocessRequest{
 				NetworkIdentifier: networkIdentifier,
 				Operations:        ops,
+				Metadata: map[string]interface{}{
+					"legacy": true,
+				},
 			},
 		)
 		assert.Nil(t, err)
-		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02", "currency":{"symbol":"AVAX","decimals":18}}`
+		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02", "currency":{"symbol":"AVAX","decimals":18}, "legacy":true}`
 		var opt options
 		assert.NoError(t, json.Unmarshal([]byte(optionsRaw), &opt))
 		assert.Equal(t, &types.ConstructionPreprocessResponse{
@@ -420,7 +491,7 @@ func TestPreprocessMetadata(t *testing.T) {
 		var ops []*types.Operation
 		assert.NoError(t, json.Unmarshal([]byte(intent), &ops))
 
-		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309"}`
+		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","legacy":true}`
 		var opt options
 		assert.NoError(t, json.Unmarshal([]byte(optionsRaw), &opt))
 
@@ -594,7 +665,7 @@ func TestPreprocessMetadata(t *testing.T) {
 		}, metadataResponse)
 	})
 
-	t.Run("fee multiplier", func(t *testing.T) {
+	t.Run("legacy fee multiplier", func(t *testing.T) {
 		var ops []*types.Operation
 		assert.NoError(t, json.Unmarshal([]byte(intent), &ops))
 		multiplier := float64(1.1)
@@ -604,10 +675,13 @@ func TestPreprocessMetadata(t *testing.T) {
 				NetworkIdentifier:      networkIdentifier,
 				Operations:             ops,
 				SuggestedFeeMultiplier: &multiplier,
+				Metadata: map[string]interface{}{
+					"legacy": true,
+				},
 			},
 		)
 		assert.Nil(t, err)
-		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02","suggested_fee_multiplier":1.1, "currency":{"decimals":18, "symbol":"AVAX"}}`
+		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02","suggested_fee_multiplier":1.1, "currency":{"decimals":18, "symbol":"AVAX"}, "legacy":true}`
 		var opt options
 		assert.NoError(t, json.Unmarshal([]byte(optionsRaw), &opt))
 		assert.Equal(t, &types.ConstructionPreprocessResponse{
@@ -665,6 +739,165 @@ func TestPreprocessMetadata(t *testing.T) {
 		}, metadataResponse)
 	})
 
+	t.Run("dynamic fee multiplier", func(t *testing.T) {
+		var ops []*types.Operation
+		assert.NoError(t, json.Unmarshal([]byte(intent), &ops))
+		multiplier := float64(1.1)
+		preprocessResponse, err := service.ConstructionPreprocess(
+			ctx,
+			&types.ConstructionPreprocessRequest{
+				NetworkIdentifier:      networkIdentifier,
+				Operations:             ops,
+				SuggestedFeeMultiplier: &multiplier,
+			},
+		)
+		assert.Nil(t, err)
+		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02","suggested_fee_multiplier":1.1, "currency":{"decimals":18, "symbol":"AVAX"}}`
+		var opt options
+		assert.NoError(t, json.Unmarshal([]byte(optionsRaw), &opt))
+		assert.Equal(t, &types.ConstructionPreprocessResponse{
+			Options: forceMarshalMap(t, &opt),
+		}, preprocessResponse)
+
+		metadata := &metadata{
+			MaxFeePerGas:         big.NewInt(28500000000),
+			MaxPriorityFeePerGas: big.NewInt(1000000000),
+			GasLimit:             21_000,
+			Nonce:                0,
+		}
+
+		to := common.HexToAddress("0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d")
+		client.On(
+			"EstimateGas",
+			ctx,
+			interfaces.CallMsg{
+				From:  common.HexToAddress("0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309"),
+				To:    &to,
+				Value: big.NewInt(42894881044106498),
+			},
+		).Return(
+			uint64(21000),
+			nil,
+		).Once()
+		client.On(
+			"EstimateBaseFee",
+			ctx,
+		).Return(
+			big.NewInt(25000000000),
+			nil,
+		).Once()
+		client.On(
+			"SuggestGasTipCap",
+			ctx,
+		).Return(
+			big.NewInt(1000000000),
+			nil,
+		).Once()
+		client.On(
+			"NonceAt",
+			ctx,
+			common.HexToAddress("0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309"),
+			(*big.Int)(nil),
+		).Return(
+			uint64(0),
+			nil,
+		).Once()
+		metadataResponse, err := service.ConstructionMetadata(ctx, &types.ConstructionMetadataRequest{
+			NetworkIdentifier: networkIdentifier,
+			Options:           forceMarshalMap(t, &opt),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, &types.ConstructionMetadataResponse{
+			Metadata: forceMarshalMap(t, metadata),
+			SuggestedFee: []*types.Amount{
+				{
+					Value:    "598500000000000",
+					Currency: mapper.AvaxCurrency,
+				},
+			},
+		}, metadataResponse)
+	})
+
+	t.Run("custom dynamic fees", func(t *testing.T) {
+		var ops []*types.Operation
+		assert.NoError(t, json.Unmarshal([]byte(intent), &ops))
+		preprocessResponse, err := service.ConstructionPreprocess(
+			ctx,
+			&types.ConstructionPreprocessRequest{
+				NetworkIdentifier: networkIdentifier,
+				Operations:        ops,
+				Metadata: map[string]interface{}{
+					"max_fee_per_gas":          "30000000000",
+					"max_priority_fee_per_gas": "2000000000",
+				},
+			},
+		)
+		assert.Nil(t, err)
+		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02", "currency":{"decimals":18, "symbol":"AVAX"}, "max_fee_per_gas":"0x6fc23ac00", "max_priority_fee_per_gas":"0x77359400"}`
+		var opt options
+		assert.NoError(t, json.Unmarshal([]byte(optionsRaw), &opt))
+		assert.Equal(t, &types.ConstructionPreprocessResponse{
+			Options: forceMarshalMap(t, &opt),
+		}, preprocessResponse)
+
+		metadata := &metadata{
+			MaxFeePerGas:         big.NewInt(30000000000),
+			MaxPriorityFeePerGas: big.NewInt(2000000000),
+			GasLimit:             21_000,
+			Nonce:                0,
+		}
+
+		to := common.HexToAddress("0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d")
+		client.On(
+			"EstimateGas",
+			ctx,
+			interfaces.CallMsg{
+				From:  common.HexToAddress("0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309"),
+				To:    &to,
+				Value: big.NewInt(42894881044106498),
+			},
+		).Return(
+			uint64(21000),
+			nil,
+		).Once()
+		client.On(
+			"NonceAt",
+			ctx,
+			common.HexToAddress("0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309"),
+			(*big.Int)(nil),
+		).Return(
+			uint64(0),
+			nil,
+		).Once()
+		metadataResponse, err := service.ConstructionMetadata(ctx, &types.ConstructionMetadataRequest{
+			NetworkIdentifier: networkIdentifier,
+			Options:           forceMarshalMap(t, &opt),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, &types.ConstructionMetadataResponse{
+			Metadata: forceMarshalMap(t, metadata),
+			SuggestedFee: []*types.Amount{
+				{
+					Value:    "630000000000000",
+					Currency: mapper.AvaxCurrency,
+				},
+			},
+		}, metadataResponse)
+	})
+
+	t.Run("priority fee above max fee", func(t *testing.T) {
+		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02", "nonce":"0x0", "gas_limit":"0x5208", "max_fee_per_gas":"0x3b9aca00", "max_priority_fee_per_gas":"0x77359400"}`
+		var opt options
+		assert.NoError(t, json.Unmarshal([]byte(optionsRaw), &opt))
+
+		metadataResponse, err := service.ConstructionMetadata(ctx, &types.ConstructionMetadataRequest{
+			NetworkIdentifier: networkIdentifier,
+			Options:           forceMarshalMap(t, &opt),
+		})
+		assert.Nil(t, metadataResponse)
+		assert.Equal(t, ErrInvalidInput.Code, err.Code)
+	})
+
 	t.Run("custom nonce", func(t *testing.T) {
 		var ops []*types.Operation
 		assert.NoError(t, json.Unmarshal([]byte(intent), &ops))
@@ -676,12 +909,13 @@ func TestPreprocessMetadata(t *testing.T) {
 				Operations:             ops,
 				SuggestedFeeMultiplier: &multiplier,
 				Metadata: map[string]interface{}{
-					"nonce": "1",
+					"nonce":  "1",
+					"legacy": true,
 				},
 			},
 		)
 		assert.Nil(t, err)
-		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02","suggested_fee_multiplier":1.1, "nonce":"0x1", "currency":{"decimals":18, "symbol":"AVAX"}}`
+		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02","suggested_fee_multiplier":1.1, "nonce":"0x1", "currency":{"decimals":18, "symbol":"AVAX"}, "legacy":true}`
 		var opt options
 		assert.NoError(t, json.Unmarshal([]byte(optionsRaw), &opt))
 		assert.Equal(t, &types.ConstructionPreprocessResponse{
@@ -742,11 +976,12 @@ func TestPreprocessMetadata(t *testing.T) {
 				SuggestedFeeMultiplier: &multiplier,
 				Metadata: map[string]interface{}{
 					"gas_limit": "40000",
+					"legacy":    true,
 				},
 			},
 		)
 		assert.Nil(t, err)
-		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02","suggested_fee_multiplier":1.1,"gas_limit":"0x9c40", "currency":{"decimals":18, "symbol":"AVAX"}}`
+		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02","suggested_fee_multiplier":1.1,"gas_limit":"0x9c40", "currency":{"decimals":18, "symbol":"AVAX"}, "legacy":true}`
 		var opt options
 		assert.NoError(t, json.Unmarshal([]byte(optionsRaw), &opt))
 		assert.Equal(t, &types.ConstructionPreprocessResponse{
@@ -791,7 +1026,7 @@ func TestPreprocessMetadata(t *testing.T) {
 		}, metadataResponse)
 	})
 
-	t.Run("basic erc20 flow", func(t *testing.T) {
+	t.Run("basic legacy erc20 flow", func(t *testing.T) {
 		erc20Intent := `[{"operation_identifier":{"index":0},"type":"ERC20_TRANSFER","account":{"address":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309"},"amount":{"value":"-42894881044106498","currency":{"symbol":"TEST","decimals":18, "metadata": {"contractAddress": "0x30e5449b6712Adf4156c8c474250F6eA4400eB82"}}}},{"operation_identifier":{"index":1},"type":"ERC20_TRANSFER","account":{"address":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d"},"amount":{"value":"42894881044106498","currency":{"symbol":"TEST","decimals":18, "metadata": {"contractAddress": "0x30e5449b6712Adf4156c8c474250F6eA4400eB82"}}}}]`
 		tokenList := []string{defaultContractAddress}
 
@@ -799,6 +1034,7 @@ func TestPreprocessMetadata(t *testing.T) {
 			config:                &Config{Mode: ModeOnline, TokenWhiteList: tokenList},
 			client:                client,
 			pChainBackend:         skippedBackend,
+			xChainBackend:         skippedBackend,
 			cChainAtomicTxBackend: skippedBackend,
 		}
 		currency := &types.Currency{Symbol: defaultSymbol, Decimals: defaultDecimals}
@@ -817,10 +1053,13 @@ func TestPreprocessMetadata(t *testing.T) {
 			&types.ConstructionPreprocessRequest{
 				NetworkIdentifier: networkIdentifier,
 				Operations:        ops,
+				Metadata: map[string]interface{}{
+					"legacy": true,
+				},
 			},
 		)
 		assert.Nil(t, err)
-		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02", "currency":{"symbol":"TEST","decimals":18, "metadata": {"contractAddress": "0x30e5449b6712Adf4156c8c474250F6eA4400eB82"}}}`
+		optionsRaw := `{"from":"0xe3a5B4d7f79d64088C8d4ef153A7DDe2B2d47309","to":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d","value":"0x9864aac3510d02", "currency":{"symbol":"TEST","decimals":18, "metadata": {"contractAddress": "0x30e5449b6712Adf4156c8c474250F6eA4400eB82"}}, "legacy":true}`
 		var opt options
 		assert.NoError(t, json.Unmarshal([]byte(optionsRaw), &opt))
 		assert.Equal(t, &types.ConstructionPreprocessResponse{
@@ -880,9 +1119,97 @@ func TestPreprocessMetadata(t *testing.T) {
 	})
 }
 
+func TestConstructionDynamicFeeTransaction(t *testing.T) {
+	ctx := context.Background()
+	skippedBackend := &backendMocks.ConstructionBackend{}
+	skippedBackend.On("ShouldHandleRequest", mock.Anything).Return(false)
+	service := ConstructionService{
+		config:                &Config{Mode: ModeOnline, ChainID: big.NewInt(mapper.FujiChainID)},
+		pChainBackend:         skippedBackend,
+		xChainBackend:         skippedBackend,
+		cChainAtomicTxBackend: skippedBackend,
+	}
+
+	key, err := ethcrypto.HexToECDSA("b6b15c8cb491557369f3c7d2c287b053eb229daa9c22138887752191c9520659")
+	assert.NoError(t, err)
+	from := ethcrypto.PubkeyToAddress(key.PublicKey).Hex()
+
+	var ops []*types.Operation
+	intent := `[{"operation_identifier":{"index":0},"type":"CALL","account":{"address":"` + from + `"},"amount":{"value":"-42894881044106498","currency":{"symbol":"AVAX","decimals":18}}},{"operation_identifier":{"index":1},"type":"CALL","account":{"address":"0x57B414a0332B5CaB885a451c2a28a07d1e9b8a8d"},"amount":{"value":"42894881044106498","currency":{"symbol":"AVAX","decimals":18}}}]`
+	assert.NoError(t, json.Unmarshal([]byte(intent), &ops))
+
+	metadata := &metadata{
+		MaxFeePerGas:         big.NewInt(26000000000),
+		MaxPriorityFeePerGas: big.NewInt(1000000000),
+		GasLimit:             21_000,
+		Nonce:                3,
+	}
+	parseMetadata := forceMarshalMap(t, &parseMetadata{
+		Nonce:                3,
+		GasLimit:             21_000,
+		ChainID:              big.NewInt(mapper.FujiChainID),
+		MaxFeePerGas:         big.NewInt(26000000000),
+		MaxPriorityFeePerGas: big.NewInt(1000000000),
+	})
+
+	payloadsResponse, terr := service.ConstructionPayloads(ctx, &types.ConstructionPayloadsRequest{
+		Operations: ops,
+		Metadata:   forceMarshalMap(t, metadata),
+	})
+	assert.Nil(t, terr)
+
+	var unsignedTx transaction
+	assert.NoError(t, json.Unmarshal([]byte(payloadsResponse.UnsignedTransaction), &unsignedTx))
+	assert.Nil(t, unsignedTx.GasPrice)
+	assert.Equal(t, big.NewInt(26000000000), unsignedTx.MaxFeePerGas)
+	assert.Equal(t, big.NewInt(1000000000), unsignedTx.MaxPriorityFeePerGas)
+
+	unsignedParseResponse, terr := service.ConstructionParse(ctx, &types.ConstructionParseRequest{
+		Signed:      false,
+		Transaction: payloadsResponse.UnsignedTransaction,
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, parseMetadata, unsignedParseResponse.Metadata)
+
+	signature, err := ethcrypto.Sign(payloadsResponse.Payloads[0].Bytes, key)
+	assert.NoError(t, err)
+
+	combineResponse, terr := service.ConstructionCombine(ctx, &types.ConstructionCombineRequest{
+		UnsignedTransaction: payloadsResponse.UnsignedTransaction,
+		Signatures: []*types.Signature{
+			{
+				Bytes: signature,
+			},
+		},
+	})
+	assert.Nil(t, terr)
+
+	signedParseResponse, terr := service.ConstructionParse(ctx, &types.ConstructionParseRequest{
+		Signed:      true,
+		Transaction: combineResponse.SignedTransaction,
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, parseMetadata, signedParseResponse.Metadata)
+	assert.Equal(t, []*types.AccountIdentifier{{Address: from}}, signedParseResponse.AccountIdentifierSigners)
+	assert.Equal(t, unsignedParseResponse.Operations, signedParseResponse.Operations)
+
+	var wrappedTx signedTransactionWrapper
+	assert.NoError(t, json.Unmarshal([]byte(combineResponse.SignedTransaction), &wrappedTx))
+	var signedTx ethtypes.Transaction
+	assert.NoError(t, signedTx.UnmarshalJSON(wrappedTx.SignedTransaction))
+	assert.Equal(t, uint8(ethtypes.DynamicFeeTxType), signedTx.Type())
+
+	hashResponse, terr := service.ConstructionHash(ctx, &types.ConstructionHashRequest{
+		SignedTransaction: combineResponse.SignedTransaction,
+	})
+	assert.Nil(t, terr)
+	assert.Equal(t, signedTx.Hash().Hex(), hashResponse.TransactionIdentifier.Hash)
+}
+
 func TestBackendDelegations(t *testing.T) {
 	testCases := []string{
 		"p-chain",
+		"x-chain",
 		"c-chain-atomic-tx",
 	}
 
@@ -915,13 +1242,15 @@ func TestBackendDelegations(t *testing.T) {
 		offlineService := ConstructionService{
 			config:                &Config{Mode: ModeOffline},
 			pChainBackend:         backends[0],
-			cChainAtomicTxBackend: backends[1],
+			xChainBackend:         backends[1],
+			cChainAtomicTxBackend: backends[2],
 		}
 
 		onlineService := ConstructionService{
 			config:                &Config{Mode: ModeOnline},
 			pChainBackend:         backends[0],
-			cChainAtomicTxBackend: backends[1],
+			xChainBackend:         backends[1],
+			cChainAtomicTxBackend: backends[2],
 		}
 
 		t.Run("Derive request is delegated to "+backendName, func(t *testing.T) {
@@ -2,25 +2,52 @@ package service
 
 import (
 	"context"
+	"errors"
+	"math/big"
+	"strconv"
 
 	"github.com/coinbase/rosetta-sdk-go/server"
 	"github.com/coinbase/rosetta-sdk-go/types"
 
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/interfaces"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
 	"github.com/ava-labs/avalanche-rosetta/client"
 	"github.com/ava-labs/avalanche-rosetta/mapper"
+	xmapper "github.com/ava-labs/avalanche-rosetta/mapper/xchain"
 )
 
+const (
+	mempoolSubPoolPending = "pending"
+	mempoolSubPoolQueued  = "queued"
+
+	metadataSubPool = "sub_pool"
+)
+
+type MempoolBackend interface {
+	ShouldHandleRequest(req interface{}) bool
+	Mempool(ctx context.Context, req *types.NetworkRequest) (*types.MempoolResponse, *types.Error)
+	MempoolTransaction(ctx context.Context, req *types.MempoolTransactionRequest) (*types.MempoolTransactionResponse, *types.Error)
+}
+
 // MempoolService implements the /mempool/* endpoints
 type MempoolService struct {
-	config *Config
-	client client.Client
+	config        *Config
+	client        client.Client
+	pChainBackend MempoolBackend
 }
 
 // NewMempoolService returns a new mempool servicer
-func NewMempoolService(config *Config, client client.Client) server.MempoolAPIServicer {
+func NewMempoolService(
+	config *Config,
+	client client.Client,
+	pChainBackend MempoolBackend,
+) server.MempoolAPIServicer {
 	return &MempoolService{
-		config: config,
-		client: client,
+		config:        config,
+		client:        client,
+		pChainBackend: pChainBackend,
 	}
 }
 
@@ -33,6 +60,13 @@ func (s MempoolService) Mempool(
 		return nil, ErrUnavailableOffline
 	}
 
+	if s.pChainBackend.ShouldHandleRequest(req) {
+		return s.pChainBackend.Mempool(ctx, req)
+	}
+	if xmapper.IsXChain(req.NetworkIdentifier) {
+		return nil, WrapError(ErrNotSupported, "x-chain mempool is not supported")
+	}
+
 	content, err := s.client.TxPoolContent(ctx)
 	if err != nil {
 		return nil, WrapError(ErrClientError, err)
@@ -51,5 +85,164 @@ func (s MempoolService) MempoolTransaction(
 	ctx context.Context,
 	req *types.MempoolTransactionRequest,
 ) (*types.MempoolTransactionResponse, *types.Error) {
-	return nil, ErrNotImplemented
+	if s.config.IsOfflineMode() {
+		return nil, ErrUnavailableOffline
+	}
+
+	if req.TransactionIdentifier == nil {
+		return nil, WrapError(ErrInvalidInput, "transaction identifier is not provided")
+	}
+
+	if s.pChainBackend.ShouldHandleRequest(req) {
+		return s.pChainBackend.MempoolTransaction(ctx, req)
+	}
+	if xmapper.IsXChain(req.NetworkIdentifier) {
+		return nil, WrapError(ErrNotSupported, "x-chain mempool is not supported")
+	}
+
+	tx, pending, err := s.client.TransactionByHash(ctx, ethcommon.HexToHash(req.TransactionIdentifier.Hash))
+	if err != nil {
+		if errors.Is(err, interfaces.NotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, WrapError(ErrClientError, err)
+	}
+	if !pending {
+		return nil, WrapError(ErrTransactionNotFound, "transaction is not in the mempool")
+	}
+
+	sender, err := ethtypes.Sender(s.config.Signer(), tx)
+	if err != nil {
+		return nil, WrapError(ErrInternalError, err)
+	}
+
+	content, err := s.client.TxPoolContent(ctx)
+	if err != nil {
+		return nil, WrapError(ErrClientError, err)
+	}
+
+	ops, err := s.mempoolOperations(tx, sender)
+	if err != nil {
+		return nil, WrapError(ErrClientError, err)
+	}
+
+	metadata := map[string]interface{}{
+		"gas":   tx.Gas(),
+		"nonce": tx.Nonce(),
+		"type":  tx.Type(),
+	}
+	if subPool, ok := mempoolSubPool(content, sender, tx.Nonce()); ok {
+		metadata[metadataSubPool] = subPool
+	}
+
+	return &types.MempoolTransactionResponse{
+		Transaction: &types.Transaction{
+			TransactionIdentifier: &types.TransactionIdentifier{
+				Hash: tx.Hash().String(),
+			},
+			Operations: ops,
+		},
+		Metadata: metadata,
+	}, nil
+}
+
+// mempoolOperations maps a pending transaction into operations. The fee is
+// estimated from the gas limit and the gas price (or fee cap) of the
+// transaction as the actual gas used is unknown until it is accepted.
+func (s MempoolService) mempoolOperations(
+	tx *ethtypes.Transaction,
+	sender ethcommon.Address,
+) ([]*types.Operation, error) {
+	estimatedFee := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasPrice())
+
+	ops := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: 0,
+			},
+			Type:    mapper.OpFee,
+			Account: mapper.Account(&sender),
+			Amount:  mapper.AvaxAmount(new(big.Int).Neg(estimatedFee)),
+		},
+	}
+
+	if tx.To() == nil {
+		return ops, nil
+	}
+
+	if tx.Value().Sign() > 0 {
+		ops = append(ops, transferOps(mapper.OpCall, sender, *tx.To(), tx.Value(), mapper.AvaxCurrency, int64(len(ops)))...)
+	}
+
+	toAddress, amount, err := parseErc20TransferData(tx.Data())
+	if err != nil {
+		// Not an ERC-20 transfer
+		return ops, nil
+	}
+
+	contract := tx.To()
+	if !s.config.IsAnalyticsMode() && !mapper.EqualFoldContains(s.config.TokenWhiteList, contract.String()) {
+		return ops, nil
+	}
+
+	symbol, decimals, err := s.client.GetContractInfo(*contract, true)
+	if err != nil {
+		return nil, err
+	}
+	if symbol == client.UnknownERC20Symbol && !s.config.IndexUnknownTokens {
+		return ops, nil
+	}
+
+	currency := mapper.ToCurrency(symbol, decimals, *contract)
+	ops = append(ops, transferOps(mapper.OpErc20Transfer, sender, *toAddress, amount, currency, int64(len(ops)))...)
+
+	return ops, nil
+}
+
+// transferOps returns a debit of from and the related credit of to
+func transferOps(
+	opType string,
+	from ethcommon.Address,
+	to ethcommon.Address,
+	value *big.Int,
+	currency *types.Currency,
+	startIndex int64,
+) []*types.Operation {
+	return []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: startIndex,
+			},
+			Type:    opType,
+			Account: mapper.Account(&from),
+			Amount:  mapper.Amount(new(big.Int).Neg(value), currency),
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: startIndex + 1,
+			},
+			RelatedOperations: []*types.OperationIdentifier{
+				{
+					Index: startIndex,
+				},
+			},
+			Type:    opType,
+			Account: mapper.Account(&to),
+			Amount:  mapper.Amount(value, currency),
+		},
+	}
+}
+
+// mempoolSubPool returns the sub-pool (pending or queued) holding the
+// transaction of sender with the given nonce
+func mempoolSubPool(content *client.TxPoolContent, sender ethcommon.Address, nonce uint64) (string, bool) {
+	key := strconv.FormatUint(nonce, 10)
+	if _, ok := content.Pending[sender.Hex()][key]; ok {
+		return mempoolSubPoolPending, true
+	}
+	if _, ok := content.Queued[sender.Hex()][key]; ok {
+		return mempoolSubPoolQueued, true
+	}
+
+	return "", false
 }
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/interfaces"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ava-labs/avalanche-rosetta/client"
+	"github.com/ava-labs/avalanche-rosetta/mapper"
+	mocks "github.com/ava-labs/avalanche-rosetta/mocks/client"
+	serviceMocks "github.com/ava-labs/avalanche-rosetta/mocks/service"
+)
+
+func TestMempool(t *testing.T) {
+	pBackendMock := &serviceMocks.MempoolBackend{}
+	mockClient := &mocks.Client{}
+	service := MempoolService{
+		config:        &Config{Mode: ModeOnline},
+		client:        mockClient,
+		pChainBackend: pBackendMock,
+	}
+
+	t.Run("p-chain request is delegated to p-chain backend", func(t *testing.T) {
+		req := &types.NetworkRequest{
+			NetworkIdentifier: &types.NetworkIdentifier{
+				Network: mapper.FujiNetwork,
+				SubNetworkIdentifier: &types.SubNetworkIdentifier{
+					Network: mapper.PChainNetworkIdentifier,
+				},
+			},
+		}
+
+		expectedResp := &types.MempoolResponse{}
+		pBackendMock.On("ShouldHandleRequest", req).Return(true)
+		pBackendMock.On("Mempool", mock.Anything, req).Return(expectedResp, nil)
+
+		resp, err := service.Mempool(context.Background(), req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResp, resp)
+		pBackendMock.AssertExpectations(t)
+	})
+
+	t.Run("c-chain request lists the tx pool", func(t *testing.T) {
+		req := &types.NetworkRequest{
+			NetworkIdentifier: &types.NetworkIdentifier{
+				Network: mapper.FujiNetwork,
+			},
+		}
+
+		pBackendMock.On("ShouldHandleRequest", req).Return(false)
+		mockClient.On("TxPoolContent", mock.Anything).Return(&client.TxPoolContent{}, nil).Once()
+
+		resp, err := service.Mempool(context.Background(), req)
+
+		assert.Nil(t, err)
+		assert.Empty(t, resp.TransactionIdentifiers)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("x-chain request is not supported", func(t *testing.T) {
+		req := &types.NetworkRequest{
+			NetworkIdentifier: &types.NetworkIdentifier{
+				Network: mapper.FujiNetwork,
+				SubNetworkIdentifier: &types.SubNetworkIdentifier{
+					Network: mapper.XChainNetworkIdentifier,
+				},
+			},
+		}
+
+		pBackendMock.On("ShouldHandleRequest", req).Return(false)
+
+		resp, err := service.Mempool(context.Background(), req)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, ErrNotSupported.Code, err.Code)
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestMempoolTransaction(t *testing.T) {
+	ctx := context.Background()
+	chainID := big.NewInt(mapper.FujiChainID)
+	key, _ := ethcrypto.HexToECDSA("b6b15c8cb491557369f3c7d2c287b053eb229daa9c22138887752191c9520659")
+	sender := ethcrypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress(defaultToAddress)
+	contract := common.HexToAddress(defaultContractAddress)
+
+	pBackendMock := &serviceMocks.MempoolBackend{}
+	pBackendMock.On("ShouldHandleRequest", mock.Anything).Return(false)
+
+	signTx := func(t *testing.T, inner ethtypes.TxData) *ethtypes.Transaction {
+		tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), inner)
+		assert.NoError(t, err)
+		return tx
+	}
+
+	t.Run("unavailable in offline mode", func(t *testing.T) {
+		service := MempoolService{config: &Config{Mode: ModeOffline}}
+
+		resp, err := service.MempoolTransaction(ctx, &types.MempoolTransactionRequest{})
+		assert.Nil(t, resp)
+		assert.Equal(t, ErrUnavailableOffline.Code, err.Code)
+	})
+
+	t.Run("p-chain request is delegated to p-chain backend", func(t *testing.T) {
+		pChainBackendMock := &serviceMocks.MempoolBackend{}
+		service := MempoolService{config: &Config{Mode: ModeOnline}, pChainBackend: pChainBackendMock}
+		req := &types.MempoolTransactionRequest{
+			NetworkIdentifier: &types.NetworkIdentifier{
+				Network: mapper.FujiNetwork,
+				SubNetworkIdentifier: &types.SubNetworkIdentifier{
+					Network: mapper.PChainNetworkIdentifier,
+				},
+			},
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: "2ryRVCwNSjEinTViuvDkzX41uQzx3g4babXxZMD46ZV1a9X4Eg"},
+		}
+
+		expectedResp := &types.MempoolTransactionResponse{}
+		pChainBackendMock.On("ShouldHandleRequest", req).Return(true)
+		pChainBackendMock.On("MempoolTransaction", ctx, req).Return(expectedResp, nil)
+
+		resp, err := service.MempoolTransaction(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResp, resp)
+		pChainBackendMock.AssertExpectations(t)
+	})
+
+	t.Run("x-chain request is not supported", func(t *testing.T) {
+		service := MempoolService{config: &Config{Mode: ModeOnline}, client: &mocks.Client{}, pChainBackend: pBackendMock}
+
+		resp, err := service.MempoolTransaction(ctx, &types.MempoolTransactionRequest{
+			NetworkIdentifier: &types.NetworkIdentifier{
+				Network: mapper.FujiNetwork,
+				SubNetworkIdentifier: &types.SubNetworkIdentifier{
+					Network: mapper.XChainNetworkIdentifier,
+				},
+			},
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: "2ryRVCwNSjEinTViuvDkzX41uQzx3g4babXxZMD46ZV1a9X4Eg"},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, ErrNotSupported.Code, err.Code)
+	})
+
+	t.Run("transaction not found", func(t *testing.T) {
+		mockClient := &mocks.Client{}
+		service := MempoolService{config: &Config{Mode: ModeOnline, ChainID: chainID}, client: mockClient, pChainBackend: pBackendMock}
+		hash := common.HexToHash("0x92ea9280c1653aa9042c7a4d3a608c2149db45064609c18b270c7c73738e2a46")
+		mockClient.On("TransactionByHash", ctx, hash).Return(nil, false, interfaces.NotFound).Once()
+
+		resp, err := service.MempoolTransaction(ctx, &types.MempoolTransactionRequest{
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: hash.String()},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, ErrTransactionNotFound.Code, err.Code)
+	})
+
+	t.Run("accepted transaction is not in the mempool", func(t *testing.T) {
+		mockClient := &mocks.Client{}
+		service := MempoolService{config: &Config{Mode: ModeOnline, ChainID: chainID}, client: mockClient, pChainBackend: pBackendMock}
+		tx := signTx(t, &ethtypes.LegacyTx{To: &to, Gas: 21000, GasPrice: big.NewInt(25000000000), Value: big.NewInt(1)})
+		mockClient.On("TransactionByHash", ctx, tx.Hash()).Return(tx, false, nil).Once()
+
+		resp, err := service.MempoolTransaction(ctx, &types.MempoolTransactionRequest{
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: tx.Hash().String()},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, ErrTransactionNotFound.Code, err.Code)
+	})
+
+	t.Run("pending native transfer", func(t *testing.T) {
+		mockClient := &mocks.Client{}
+		service := MempoolService{config: &Config{Mode: ModeOnline, ChainID: chainID}, client: mockClient, pChainBackend: pBackendMock}
+		tx := signTx(t, &ethtypes.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     2,
+			To:        &to,
+			Gas:       21000,
+			GasTipCap: big.NewInt(1000000000),
+			GasFeeCap: big.NewInt(26000000000),
+			Value:     big.NewInt(42894881044106498),
+		})
+		mockClient.On("TransactionByHash", ctx, tx.Hash()).Return(tx, true, nil).Once()
+		mockClient.On("TxPoolContent", ctx).Return(&client.TxPoolContent{
+			Pending: client.TxAccountMap{
+				sender.Hex(): client.TxNonceMap{"2": "summary"},
+			},
+		}, nil).Once()
+
+		resp, err := service.MempoolTransaction(ctx, &types.MempoolTransactionRequest{
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: tx.Hash().String()},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 0},
+				Type:                mapper.OpFee,
+				Account:             &types.AccountIdentifier{Address: sender.Hex()},
+				Amount:              mapper.AvaxAmount(big.NewInt(-546000000000000)),
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 1},
+				Type:                mapper.OpCall,
+				Account:             &types.AccountIdentifier{Address: sender.Hex()},
+				Amount:              mapper.AvaxAmount(big.NewInt(-42894881044106498)),
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 2},
+				RelatedOperations:   []*types.OperationIdentifier{{Index: 1}},
+				Type:                mapper.OpCall,
+				Account:             &types.AccountIdentifier{Address: defaultToAddress},
+				Amount:              mapper.AvaxAmount(big.NewInt(42894881044106498)),
+			},
+		}, resp.Transaction.Operations)
+		assert.Equal(t, mempoolSubPoolPending, resp.Metadata[metadataSubPool])
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("queued erc20 transfer", func(t *testing.T) {
+		mockClient := &mocks.Client{}
+		service := MempoolService{
+			config:        &Config{Mode: ModeOnline, ChainID: chainID, TokenWhiteList: []string{defaultContractAddress}},
+			client:        mockClient,
+			pChainBackend: pBackendMock,
+		}
+		tx := signTx(t, &ethtypes.LegacyTx{
+			Nonce:    5,
+			To:       &contract,
+			Gas:      50000,
+			GasPrice: big.NewInt(25000000000),
+			Value:    big.NewInt(0),
+			Data:     generateErc20TransferData(defaultToAddress, big.NewInt(1000)),
+		})
+		mockClient.On("TransactionByHash", ctx, tx.Hash()).Return(tx, true, nil).Once()
+		mockClient.On("TxPoolContent", ctx).Return(&client.TxPoolContent{
+			Queued: client.TxAccountMap{
+				sender.Hex(): client.TxNonceMap{"5": "summary"},
+			},
+		}, nil).Once()
+		mockClient.On("GetContractInfo", contract, true).Return(defaultSymbol, uint8(defaultDecimals), nil).Once()
+
+		resp, err := service.MempoolTransaction(ctx, &types.MempoolTransactionRequest{
+			TransactionIdentifier: &types.TransactionIdentifier{Hash: tx.Hash().String()},
+		})
+		assert.Nil(t, err)
+		currency := mapper.ToCurrency(defaultSymbol, defaultDecimals, contract)
+		assert.Equal(t, []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 0},
+				Type:                mapper.OpFee,
+				Account:             &types.AccountIdentifier{Address: sender.Hex()},
+				Amount:              mapper.AvaxAmount(big.NewInt(-1250000000000000)),
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 1},
+				Type:                mapper.OpErc20Transfer,
+				Account:             &types.AccountIdentifier{Address: sender.Hex()},
+				Amount:              mapper.Amount(big.NewInt(-1000), currency),
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 2},
+				RelatedOperations:   []*types.OperationIdentifier{{Index: 1}},
+				Type:                mapper.OpErc20Transfer,
+				Account:             &types.AccountIdentifier{Address: defaultToAddress},
+				Amount:              mapper.Amount(big.NewInt(1000), currency),
+			},
+		}, resp.Transaction.Operations)
+		assert.Equal(t, mempoolSubPoolQueued, resp.Metadata[metadataSubPool])
+		mockClient.AssertExpectations(t)
+	})
+}
@@ -24,6 +24,7 @@ type NetworkService struct {
 	config        *Config
 	client        client.Client
 	pChainBackend NetworkBackend
+	xChainBackend NetworkBackend
 	genesisBlock  *types.Block
 }
 
@@ -32,6 +33,7 @@ func NewNetworkService(
 	config *Config,
 	client client.Client,
 	pChainBackend NetworkBackend,
+	xChainBackend NetworkBackend,
 ) server.NetworkAPIServicer {
 	genesisBlock := makeGenesisBlock(config.GenesisBlockHash)
 
@@ -39,6 +41,7 @@ func NewNetworkService(
 		config:        config,
 		client:        client,
 		pChainBackend: pChainBackend,
+		xChainBackend: xChainBackend,
 		genesisBlock:  genesisBlock,
 	}
 }
@@ -52,6 +55,7 @@ func (s *NetworkService) NetworkList(
 		NetworkIdentifiers: []*types.NetworkIdentifier{
 			s.config.NetworkID,
 			s.pChainBackend.NetworkIdentifier(),
+			s.xChainBackend.NetworkIdentifier(),
 		},
 	}, nil
 }
@@ -69,6 +73,10 @@ func (s *NetworkService) NetworkStatus(
 		return s.pChainBackend.NetworkStatus(ctx, request)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(request) {
+		return s.xChainBackend.NetworkStatus(ctx, request)
+	}
+
 	// Fetch peers
 	infoPeers, err := s.client.Peers(ctx)
 	if err != nil {
@@ -132,6 +140,10 @@ func (s *NetworkService) NetworkOptions(
 		return s.pChainBackend.NetworkOptions(ctx, request)
 	}
 
+	if s.xChainBackend.ShouldHandleRequest(request) {
+		return s.xChainBackend.NetworkOptions(ctx, request)
+	}
+
 	return &types.NetworkOptionsResponse{
 		Version: &types.Version{
 			RosettaVersion:    types.RosettaAPIVersion,
@@ -6,7 +6,9 @@ import (
 	"strconv"
 
 	"github.com/ava-labs/avalanche-rosetta/mapper"
+	ethtypes "github.com/ava-labs/coreth/core/types"
 	"github.com/coinbase/rosetta-sdk-go/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -21,6 +23,9 @@ type options struct {
 	GasLimit               *big.Int        `json:"gas_limit,omitempty"`
 	Nonce                  *big.Int        `json:"nonce,omitempty"`
 	Currency               *types.Currency `json:"currency,omitempty"`
+	MaxFeePerGas           *big.Int        `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas   *big.Int        `json:"max_priority_fee_per_gas,omitempty"`
+	Legacy                 bool            `json:"legacy,omitempty"`
 }
 
 type optionsWire struct {
@@ -32,6 +37,9 @@ type optionsWire struct {
 	GasLimit               string          `json:"gas_limit,omitempty"`
 	Nonce                  string          `json:"nonce,omitempty"`
 	Currency               *types.Currency `json:"currency,omitempty"`
+	MaxFeePerGas           string          `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas   string          `json:"max_priority_fee_per_gas,omitempty"`
+	Legacy                 bool            `json:"legacy,omitempty"`
 }
 
 func (o *options) MarshalJSON() ([]byte, error) {
@@ -40,6 +48,7 @@ func (o *options) MarshalJSON() ([]byte, error) {
 		To:                     o.To,
 		SuggestedFeeMultiplier: o.SuggestedFeeMultiplier,
 		Currency:               o.Currency,
+		Legacy:                 o.Legacy,
 	}
 	if o.Value != nil {
 		ow.Value = hexutil.EncodeBig(o.Value)
@@ -53,6 +62,12 @@ func (o *options) MarshalJSON() ([]byte, error) {
 	if o.Nonce != nil {
 		ow.Nonce = hexutil.EncodeBig(o.Nonce)
 	}
+	if o.MaxFeePerGas != nil {
+		ow.MaxFeePerGas = hexutil.EncodeBig(o.MaxFeePerGas)
+	}
+	if o.MaxPriorityFeePerGas != nil {
+		ow.MaxPriorityFeePerGas = hexutil.EncodeBig(o.MaxPriorityFeePerGas)
+	}
 
 	return json.Marshal(ow)
 }
@@ -66,6 +81,7 @@ func (o *options) UnmarshalJSON(data []byte) error {
 	o.To = ow.To
 	o.SuggestedFeeMultiplier = ow.SuggestedFeeMultiplier
 	o.Currency = ow.Currency
+	o.Legacy = ow.Legacy
 
 	if len(ow.Value) > 0 {
 		value, err := hexutil.DecodeBig(ow.Value)
@@ -99,27 +115,57 @@ func (o *options) UnmarshalJSON(data []byte) error {
 		o.Nonce = nonce
 	}
 
+	if len(ow.MaxFeePerGas) > 0 {
+		maxFeePerGas, err := hexutil.DecodeBig(ow.MaxFeePerGas)
+		if err != nil {
+			return err
+		}
+		o.MaxFeePerGas = maxFeePerGas
+	}
+
+	if len(ow.MaxPriorityFeePerGas) > 0 {
+		maxPriorityFeePerGas, err := hexutil.DecodeBig(ow.MaxPriorityFeePerGas)
+		if err != nil {
+			return err
+		}
+		o.MaxPriorityFeePerGas = maxPriorityFeePerGas
+	}
+
 	return nil
 }
 
+// metadata holds either a legacy GasPrice or the EIP-1559
+// MaxFeePerGas/MaxPriorityFeePerGas pair.
 type metadata struct {
-	Nonce    uint64   `json:"nonce"`
-	GasPrice *big.Int `json:"gas_price"`
-	GasLimit uint64   `json:"gas_limit"`
+	Nonce                uint64   `json:"nonce"`
+	GasPrice             *big.Int `json:"gas_price,omitempty"`
+	GasLimit             uint64   `json:"gas_limit"`
+	MaxFeePerGas         *big.Int `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas *big.Int `json:"max_priority_fee_per_gas,omitempty"`
 }
 
 type metadataWire struct {
-	Nonce    string `json:"nonce"`
-	GasPrice string `json:"gas_price"`
-	GasLimit string `json:"gas_limit"`
+	Nonce                string `json:"nonce"`
+	GasPrice             string `json:"gas_price,omitempty"`
+	GasLimit             string `json:"gas_limit"`
+	MaxFeePerGas         string `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string `json:"max_priority_fee_per_gas,omitempty"`
 }
 
 func (m *metadata) MarshalJSON() ([]byte, error) {
 	mw := &metadataWire{
 		Nonce:    hexutil.Uint64(m.Nonce).String(),
-		GasPrice: hexutil.EncodeBig(m.GasPrice),
 		GasLimit: hexutil.Uint64(m.GasLimit).String(),
 	}
+	if m.GasPrice != nil {
+		mw.GasPrice = hexutil.EncodeBig(m.GasPrice)
+	}
+	if m.MaxFeePerGas != nil {
+		mw.MaxFeePerGas = hexutil.EncodeBig(m.MaxFeePerGas)
+	}
+	if m.MaxPriorityFeePerGas != nil {
+		mw.MaxPriorityFeePerGas = hexutil.EncodeBig(m.MaxPriorityFeePerGas)
+	}
 
 	return json.Marshal(mw)
 }
@@ -130,11 +176,29 @@ func (m *metadata) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	gasPrice, err := hexutil.DecodeBig(mw.GasPrice)
-	if err != nil {
-		return err
+	if len(mw.GasPrice) > 0 {
+		gasPrice, err := hexutil.DecodeBig(mw.GasPrice)
+		if err != nil {
+			return err
+		}
+		m.GasPrice = gasPrice
+	}
+
+	if len(mw.MaxFeePerGas) > 0 {
+		maxFeePerGas, err := hexutil.DecodeBig(mw.MaxFeePerGas)
+		if err != nil {
+			return err
+		}
+		m.MaxFeePerGas = maxFeePerGas
+	}
+
+	if len(mw.MaxPriorityFeePerGas) > 0 {
+		maxPriorityFeePerGas, err := hexutil.DecodeBig(mw.MaxPriorityFeePerGas)
+		if err != nil {
+			return err
+		}
+		m.MaxPriorityFeePerGas = maxPriorityFeePerGas
 	}
-	m.GasPrice = gasPrice
 
 	gasLimit, err := hexutil.DecodeUint64(mw.GasLimit)
 	if err != nil {
@@ -152,52 +216,70 @@ func (m *metadata) UnmarshalJSON(data []byte) error {
 }
 
 type parseMetadata struct {
-	Nonce    uint64   `json:"nonce"`
-	GasPrice *big.Int `json:"gas_price"`
-	GasLimit uint64   `json:"gas_limit"`
-	ChainID  *big.Int `json:"chain_id"`
+	Nonce                uint64   `json:"nonce"`
+	GasPrice             *big.Int `json:"gas_price,omitempty"`
+	GasLimit             uint64   `json:"gas_limit"`
+	ChainID              *big.Int `json:"chain_id"`
+	MaxFeePerGas         *big.Int `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas *big.Int `json:"max_priority_fee_per_gas,omitempty"`
 }
 
 type parseMetadataWire struct {
-	Nonce    string `json:"nonce"`
-	GasPrice string `json:"gas_price"`
-	GasLimit string `json:"gas_limit"`
-	ChainID  string `json:"chain_id"`
+	Nonce                string `json:"nonce"`
+	GasPrice             string `json:"gas_price,omitempty"`
+	GasLimit             string `json:"gas_limit"`
+	ChainID              string `json:"chain_id"`
+	MaxFeePerGas         string `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string `json:"max_priority_fee_per_gas,omitempty"`
 }
 
 func (p *parseMetadata) MarshalJSON() ([]byte, error) {
 	pmw := &parseMetadataWire{
 		Nonce:    hexutil.Uint64(p.Nonce).String(),
-		GasPrice: hexutil.EncodeBig(p.GasPrice),
 		GasLimit: hexutil.Uint64(p.GasLimit).String(),
 		ChainID:  hexutil.EncodeBig(p.ChainID),
 	}
+	if p.GasPrice != nil {
+		pmw.GasPrice = hexutil.EncodeBig(p.GasPrice)
+	}
+	if p.MaxFeePerGas != nil {
+		pmw.MaxFeePerGas = hexutil.EncodeBig(p.MaxFeePerGas)
+	}
+	if p.MaxPriorityFeePerGas != nil {
+		pmw.MaxPriorityFeePerGas = hexutil.EncodeBig(p.MaxPriorityFeePerGas)
+	}
 
 	return json.Marshal(pmw)
 }
 
+// transaction is the unsigned transaction wire type. Dynamic fee (EIP-1559)
+// transactions set MaxFeePerGas and MaxPriorityFeePerGas instead of GasPrice.
 type transaction struct {
-	From     string          `json:"from"`
-	To       string          `json:"to"`
-	Value    *big.Int        `json:"value"`
-	Data     []byte          `json:"data"`
-	Nonce    uint64          `json:"nonce"`
-	GasPrice *big.Int        `json:"gas_price"`
-	GasLimit uint64          `json:"gas"`
-	ChainID  *big.Int        `json:"chain_id"`
-	Currency *types.Currency `json:"currency,omitempty"`
+	From                 string          `json:"from"`
+	To                   string          `json:"to"`
+	Value                *big.Int        `json:"value"`
+	Data                 []byte          `json:"data"`
+	Nonce                uint64          `json:"nonce"`
+	GasPrice             *big.Int        `json:"gas_price,omitempty"`
+	GasLimit             uint64          `json:"gas"`
+	ChainID              *big.Int        `json:"chain_id"`
+	Currency             *types.Currency `json:"currency,omitempty"`
+	MaxFeePerGas         *big.Int        `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas *big.Int        `json:"max_priority_fee_per_gas,omitempty"`
 }
 
 type transactionWire struct {
-	From     string          `json:"from"`
-	To       string          `json:"to"`
-	Value    string          `json:"value"`
-	Data     string          `json:"data"`
-	Nonce    string          `json:"nonce"`
-	GasPrice string          `json:"gas_price"`
-	GasLimit string          `json:"gas"`
-	ChainID  string          `json:"chain_id"`
-	Currency *types.Currency `json:"currency,omitempty"`
+	From                 string          `json:"from"`
+	To                   string          `json:"to"`
+	Value                string          `json:"value"`
+	Data                 string          `json:"data"`
+	Nonce                string          `json:"nonce"`
+	GasPrice             string          `json:"gas_price,omitempty"`
+	GasLimit             string          `json:"gas"`
+	ChainID              string          `json:"chain_id"`
+	Currency             *types.Currency `json:"currency,omitempty"`
+	MaxFeePerGas         string          `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string          `json:"max_priority_fee_per_gas,omitempty"`
 }
 
 func (t *transaction) MarshalJSON() ([]byte, error) {
@@ -207,11 +289,19 @@ func (t *transaction) MarshalJSON() ([]byte, error) {
 		Value:    hexutil.EncodeBig(t.Value),
 		Data:     hexutil.Encode(t.Data),
 		Nonce:    hexutil.EncodeUint64(t.Nonce),
-		GasPrice: hexutil.EncodeBig(t.GasPrice),
 		GasLimit: hexutil.EncodeUint64(t.GasLimit),
 		ChainID:  hexutil.EncodeBig(t.ChainID),
 		Currency: t.Currency,
 	}
+	if t.GasPrice != nil {
+		tw.GasPrice = hexutil.EncodeBig(t.GasPrice)
+	}
+	if t.MaxFeePerGas != nil {
+		tw.MaxFeePerGas = hexutil.EncodeBig(t.MaxFeePerGas)
+	}
+	if t.MaxPriorityFeePerGas != nil {
+		tw.MaxPriorityFeePerGas = hexutil.EncodeBig(t.MaxPriorityFeePerGas)
+	}
 
 	return json.Marshal(tw)
 }
@@ -237,9 +327,28 @@ func (t *transaction) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	gasPrice, err := hexutil.DecodeBig(tw.GasPrice)
-	if err != nil {
-		return err
+	var gasPrice *big.Int
+	if len(tw.GasPrice) > 0 {
+		gasPrice, err = hexutil.DecodeBig(tw.GasPrice)
+		if err != nil {
+			return err
+		}
+	}
+
+	var maxFeePerGas *big.Int
+	if len(tw.MaxFeePerGas) > 0 {
+		maxFeePerGas, err = hexutil.DecodeBig(tw.MaxFeePerGas)
+		if err != nil {
+			return err
+		}
+	}
+
+	var maxPriorityFeePerGas *big.Int
+	if len(tw.MaxPriorityFeePerGas) > 0 {
+		maxPriorityFeePerGas, err = hexutil.DecodeBig(tw.MaxPriorityFeePerGas)
+		if err != nil {
+			return err
+		}
 	}
 
 	gasLimit, err := hexutil.DecodeUint64(tw.GasLimit)
@@ -262,9 +371,42 @@ func (t *transaction) UnmarshalJSON(data []byte) error {
 	t.ChainID = chainID
 	t.GasPrice = gasPrice
 	t.Currency = tw.Currency
+	t.MaxFeePerGas = maxFeePerGas
+	t.MaxPriorityFeePerGas = maxPriorityFeePerGas
 	return nil
 }
 
+// isDynamicFee returns true if t is an EIP-1559 transaction
+func (t *transaction) isDynamicFee() bool {
+	return t.MaxFeePerGas != nil
+}
+
+// ethTransaction returns the unsigned coreth transaction described by t
+func (t *transaction) ethTransaction() *ethtypes.Transaction {
+	to := ethcommon.HexToAddress(t.To)
+	if t.isDynamicFee() {
+		return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			ChainID:   t.ChainID,
+			Nonce:     t.Nonce,
+			GasTipCap: t.MaxPriorityFeePerGas,
+			GasFeeCap: t.MaxFeePerGas,
+			Gas:       t.GasLimit,
+			To:        &to,
+			Value:     t.Value,
+			Data:      t.Data,
+		})
+	}
+
+	return ethtypes.NewTransaction(
+		t.Nonce,
+		to,
+		t.Value,
+		t.GasLimit,
+		t.GasPrice,
+		t.Data,
+	)
+}
+
 type accountMetadata struct {
 	Nonce uint64 `json:"nonce"`
 }